@@ -148,7 +148,7 @@ function TransportationDialogContent({
       return
     }
 
-    const geoJson = await directionsResponse.json()
+    const { geoJson } = await directionsResponse.json()
     enrichGeoJsonPoints(geoJson, values)
 
     if (transportation) {
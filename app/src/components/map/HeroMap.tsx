@@ -8,6 +8,7 @@ import type { GeoJsonProperties } from "geojson"
 import type {
   GeoJsonFlight,
   GeoJsonTrain,
+  GeoJsonTrainStopover,
   GeoJsonTransportation,
 } from "@/components/map/types"
 import type { LngLat } from "maplibre-gl"
@@ -15,6 +16,7 @@ import { useTrip } from "@/components/provider/TripProvider"
 import BaseMap from "@/components/map/BaseMap.tsx"
 import FlightPopup from "@/components/map/popup/FlightPopup.tsx"
 import TrainPopup from "@/components/map/popup/TrainPopup.tsx"
+import TrainStopoverPopup from "@/components/map/popup/TrainStopoverPopup.tsx"
 import TransportationPopup from "@/components/map/popup/TransportationPopup"
 import { useTransportationSubscription } from "@/repo"
 
@@ -59,6 +61,10 @@ export default function HeroMap() {
         return <FlightPopup properties={props as GeoJsonFlight} />
       case "TRAIN":
         return <TrainPopup properties={props as GeoJsonTrain} />
+      case "TRAIN_STOPOVER":
+        return (
+          <TrainStopoverPopup properties={props as GeoJsonTrainStopover} />
+        )
       default:
         return (
           <TransportationPopup properties={props as GeoJsonTransportation} />
@@ -4,6 +4,8 @@ import type { GeoJSONFeatureCollection } from "zod-geojson"
 import { useTransportationSubscription } from "@/repo"
 import { useTrip } from "@/components/provider/TripProvider"
 
+const transferTypes = ["WALKING", "TRANSFER"]
+
 export default function TransportationLayer() {
   const trip = useTrip()
   const { transportation } = useTransportationSubscription(trip.stid)
@@ -69,21 +71,42 @@ export default function TransportationLayer() {
         <Source key={idx} type="geojson" data={geoJson}>
           <Layer
             type="line"
+            filter={["!", ["in", ["get", "type"], ["literal", transferTypes]]]}
             paint={{
               "line-color": getColorByType(transportation),
               "line-width": 5,
             }}
             layout={{ "line-cap": "round" }}
           />
+          <Layer
+            type="line"
+            filter={["in", ["get", "type"], ["literal", transferTypes]]}
+            paint={{
+              "line-color": getColorByType(transportation),
+              "line-width": 3,
+              "line-dasharray": [1, 2],
+            }}
+            layout={{ "line-cap": "round" }}
+          />
           <Layer
             type="circle"
             id={"geojson" + idx}
             filter={["==", ["geometry-type"], "Point"]}
             paint={{
               "circle-color": getColorByType(transportation),
-              "circle-radius": 5,
+              "circle-radius": [
+                "case",
+                ["==", ["get", "type"], "TRAIN_STOPOVER"],
+                3,
+                5,
+              ],
               "circle-stroke-color": "white",
-              "circle-stroke-width": 3,
+              "circle-stroke-width": [
+                "case",
+                ["==", ["get", "type"], "TRAIN_STOPOVER"],
+                2,
+                3,
+              ],
             }}
           />
         </Source>
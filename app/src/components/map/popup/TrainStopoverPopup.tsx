@@ -0,0 +1,24 @@
+import type { GeoJsonTrainStopover } from "@/components/map/types"
+import { formatTimePadded } from "@/lib/formatting"
+
+export default function TrainStopoverPopup({
+  properties,
+}: {
+  properties: GeoJsonTrainStopover
+}) {
+  const time = properties.departureDateTime ?? properties.arrivalDateTime
+
+  return (
+    <div className="text-sm">
+      <strong>
+        🚏 {properties.station}
+        {properties.cancelled && " (cancelled)"}
+      </strong>
+      <div className="iconsolata grid grid-cols-[auto_auto_1fr] gap-x-2">
+        <span>{time && formatTimePadded(time)}</span>
+        <span className="text-right">{properties.lineName}</span>
+        <span>{properties.platform && `Pl. ${properties.platform}`}</span>
+      </div>
+    </div>
+  )
+}
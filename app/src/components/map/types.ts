@@ -28,6 +28,18 @@ export type GeoJsonTrainLeg = {
   arrivalDateTime: string
   fromStation: string
   toStation: string
+  departurePlatform: string | null
+  arrivalPlatform: string | null
+}
+
+export type GeoJsonTrainStopover = {
+  type: "TRAIN_STOPOVER"
+  lineName: string
+  station: string
+  arrivalDateTime: string | null
+  departureDateTime: string | null
+  platform: string | null
+  cancelled: boolean
 }
 
 export type GeoJsonTransportation = {
@@ -2,6 +2,10 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
@@ -13,6 +17,11 @@ type (
 		Metrics Metrics
 		Swagger Swagger
 		WebApi  WebApi
+		Cache   Cache
+		Batch   Batch
+		Watch   Watch
+		Live    Live
+		WebPush WebPush
 	}
 
 	HTTP struct {
@@ -32,14 +41,72 @@ type (
 		Enabled bool `env:"SWAGGER_ENABLED" envDefault:"false"`
 	}
 
+	// Cache configures the cache of upstream responses. TTLs override the
+	// fresh TTL of single methods, e.g. RetrieveFlightLeg=5m.
+	Cache struct {
+		Backend  string                   `env:"CACHE_BACKEND" envDefault:"memory"`
+		Size     int                      `env:"CACHE_SIZE" envDefault:"10000"`
+		RedisURL string                   `env:"REDIS_URL"`
+		StaleTTL time.Duration            `env:"CACHE_STALE_TTL" envDefault:"24h"`
+		TTLs     map[string]time.Duration `env:"CACHE_TTLS" envKeyValSeparator:"="`
+	}
+
+	// Batch limits the lookups running concurrently across all batches.
+	Batch struct {
+		Concurrency int `env:"BATCH_CONCURRENCY" envDefault:"8"`
+	}
+
+	// Watch configures the monitoring of flights and trains. Webhooks are
+	// signed with the secret.
+	Watch struct {
+		StorePath     string        `env:"WATCH_STORE_PATH" envDefault:"data/watches.db"`
+		WebhookSecret string        `env:"WATCH_WEBHOOK_SECRET"`
+		Interval      time.Duration `env:"WATCH_INTERVAL" envDefault:"1m"`
+		Concurrency   int           `env:"WATCH_CONCURRENCY" envDefault:"4"`
+	}
+
+	// Live configures the polling of flights and trains followed by live
+	// streams.
+	Live struct {
+		Interval    time.Duration `env:"LIVE_INTERVAL" envDefault:"1m"`
+		Concurrency int           `env:"LIVE_CONCURRENCY" envDefault:"4"`
+	}
+
+	// WebPush configures the VAPID keys of Web Push messages. The private key
+	// is the unpadded base64url encoded P-256 scalar, the subject a mailto: or
+	// https: contact of the operator. Web Push is disabled without a key.
+	WebPush struct {
+		VapidPrivateKey string        `env:"VAPID_PRIVATE_KEY"`
+		VapidSubject    string        `env:"VAPID_SUBJECT"`
+		TTL             time.Duration `env:"WEB_PUSH_TTL" envDefault:"24h"`
+	}
+
 	WebApi struct {
-		AmadeusBaseURL          string `env:"AMADEUS_URL" envDefault:"https://api.amadeus.com"`
-		AmadeusApiKey           string `env:"AMADEUS_APIKEY"`
-		AmadeusApiSecret        string `env:"AMADEUS_APISECRET"`
-		DbVendoBaseURL          string `env:"DBVENDO_URL"`
-		OpenTravelDataBaseURL   string `env:"OPTD_URL" envDefault:"https://raw.githubusercontent.com/opentraveldata/opentraveldata/refs/heads/master/opentraveldata"`
-		OpenRouteServiceBaseURL string `env:"ORS_URL" envDefault:"https://api.openrouteservice.org"`
-		OpenRouteServiceApiKey  string `env:"ORS_APIKEY"`
+		AmadeusBaseURL          string            `env:"AMADEUS_URL" envDefault:"https://api.amadeus.com"`
+		AmadeusApiKey           string            `env:"AMADEUS_APIKEY"`
+		AmadeusApiSecret        string            `env:"AMADEUS_APISECRET"`
+		DbVendoBaseURL          string            `env:"DBVENDO_URL"`
+		RailProviderBaseURLs    map[string]string `env:"RAIL_PROVIDERS" envKeyValSeparator:"="`
+		RailProviderCountries   map[string]string `env:"RAIL_PROVIDER_COUNTRIES" envKeyValSeparator:"="`
+		GtfsFeedURLs            map[string]string `env:"GTFS_FEEDS" envKeyValSeparator:"="`
+		GtfsRefreshInterval     time.Duration     `env:"GTFS_REFRESH_INTERVAL" envDefault:"24h"`
+		GtfsRealtimeURLs        map[string]string `env:"GTFS_RT_FEEDS" envKeyValSeparator:"="`
+		GtfsRealtimeInterval    time.Duration     `env:"GTFS_RT_INTERVAL" envDefault:"60s"`
+		OpenTravelDataBaseURL   string            `env:"OPTD_URL" envDefault:"https://raw.githubusercontent.com/opentraveldata/opentraveldata/refs/heads/master/opentraveldata"`
+		OpenRouteServiceBaseURL string            `env:"ORS_URL" envDefault:"https://api.openrouteservice.org"`
+		OpenRouteServiceApiKey  string            `env:"ORS_APIKEY"`
+		Geocoders               []string          `env:"GEOCODERS" envDefault:"ors"`
+		NominatimBaseURL        string            `env:"NOMINATIM_URL"`
+		PhotonBaseURL           string            `env:"PHOTON_URL"`
+		PeliasBaseURL           string            `env:"PELIAS_URL"`
+		Routers                 map[string]string `env:"ROUTERS" envKeyValSeparator:"=" envDefault:"FERRY=sea,BOAT=sea"`
+		DefaultRouter           string            `env:"DEFAULT_ROUTER" envDefault:"ors"`
+		OsrmBaseURL             string            `env:"OSRM_URL"`
+		ValhallaBaseURL         string            `env:"VALHALLA_URL"`
+		GraphHopperBaseURL      string            `env:"GRAPHHOPPER_URL" envDefault:"https://graphhopper.com/api/1"`
+		GraphHopperApiKey       string            `env:"GRAPHHOPPER_APIKEY"`
+		PoiSearch               string            `env:"POI_SEARCH" envDefault:"ors"`
+		OverpassBaseURL         string            `env:"OVERPASS_URL" envDefault:"https://overpass-api.de/api"`
 	}
 )
 
@@ -49,5 +116,46 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("config error: %w", err)
 	}
 
+	// intervals drive tickers of the background loops, which require them to be positive
+	for name, interval := range map[string]time.Duration{
+		"GTFS_REFRESH_INTERVAL": cfg.WebApi.GtfsRefreshInterval,
+		"GTFS_RT_INTERVAL":      cfg.WebApi.GtfsRealtimeInterval,
+		"WATCH_INTERVAL":        cfg.Watch.Interval,
+		"LIVE_INTERVAL":         cfg.Live.Interval,
+	} {
+		if interval <= 0 {
+			return nil, fmt.Errorf("config error: %s must be positive, got %s", name, interval)
+		}
+	}
+
+	// self-hosted geocoders have no public default instance
+	geocoderURLs := map[string]string{
+		"nominatim": cfg.WebApi.NominatimBaseURL,
+		"photon":    cfg.WebApi.PhotonBaseURL,
+		"pelias":    cfg.WebApi.PeliasBaseURL,
+	}
+	for _, name := range cfg.WebApi.Geocoders {
+		if baseURL, ok := geocoderURLs[name]; ok && baseURL == "" {
+			return nil, fmt.Errorf("config error: %s_URL is required for geocoder %s", strings.ToUpper(name), name)
+		}
+	}
+
+	// as are self-hosted routers, while the hosted GraphHopper requires a key
+	routerURLs := map[string]string{
+		"osrm":        cfg.WebApi.OsrmBaseURL,
+		"valhalla":    cfg.WebApi.ValhallaBaseURL,
+		"graphhopper": cfg.WebApi.GraphHopperBaseURL,
+	}
+	routers := append([]string{cfg.WebApi.DefaultRouter}, slices.Collect(maps.Values(cfg.WebApi.Routers))...)
+	for _, name := range routers {
+		if baseURL, ok := routerURLs[name]; ok && baseURL == "" {
+			return nil, fmt.Errorf("config error: %s_URL is required for router %s", strings.ToUpper(name), name)
+		}
+	}
+	if slices.Contains(routers, "graphhopper") && cfg.WebApi.GraphHopperApiKey == "" &&
+		strings.Contains(cfg.WebApi.GraphHopperBaseURL, "graphhopper.com") {
+		return nil, fmt.Errorf("config error: GRAPHHOPPER_APIKEY is required for the hosted GraphHopper API")
+	}
+
 	return cfg, nil
 }
@@ -6,10 +6,10 @@ import "github.com/swaggo/swag/v2"
 
 const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
-    "components": {"schemas":{"entity.Airport":{"properties":{"iata":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"municipality":{"type":"string"},"name":{"type":"string"}},"required":["iata","location","municipality","name"],"type":"object"},"entity.AmbiguousFlightChoice":{"properties":{"departureDateTime":{"type":"string"},"destinationIata":{"type":"string"},"originIata":{"type":"string"}},"required":["departureDateTime","destinationIata","originIata"],"type":"object"},"entity.ErrAmbiguousFlightRequest":{"additionalProperties":{"items":{"$ref":"#/components/schemas/entity.AmbiguousFlightChoice"},"type":"array"},"type":"object"},"entity.Flight":{"properties":{"geoJson":{"type":"object"},"legs":{"items":{"$ref":"#/components/schemas/entity.FlightLeg"},"type":"array","uniqueItems":false}},"required":["geoJson","legs"],"type":"object"},"entity.FlightLeg":{"properties":{"aircraft":{"nullable":true,"type":"string"},"airline":{"type":"string"},"amadeusFlightDate":{"nullable":true,"type":"string"},"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Airport"},"durationInMinutes":{"type":"integer"},"flightNumber":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.Airport"}},"required":["aircraft","airline","amadeusFlightDate","arrivalDateTime","departureDateTime","destination","durationInMinutes","flightNumber","origin"],"type":"object"},"entity.Location":{"properties":{"latitude":{"type":"number"},"longitude":{"type":"number"}},"required":["latitude","longitude"],"type":"object"},"entity.Train":{"properties":{"geoJson":{"type":"object"},"legs":{"items":{"$ref":"#/components/schemas/entity.TrainLeg"},"type":"array","uniqueItems":false},"refreshToken":{"type":"string"}},"required":["geoJson","legs","refreshToken"],"type":"object"},"entity.TrainLeg":{"properties":{"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.TrainStation"},"durationInMinutes":{"type":"integer"},"lineName":{"type":"string"},"operatorName":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.TrainStation"}},"required":["arrivalDateTime","departureDateTime","destination","durationInMinutes","lineName","operatorName","origin"],"type":"object"},"entity.TrainStation":{"properties":{"id":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"}},"required":["id","location","name"],"type":"object"},"entity.TransportationType":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]},"request.Directions":{"properties":{"end":{"$ref":"#/components/schemas/entity.Location"},"start":{"$ref":"#/components/schemas/entity.Location"},"transportationType":{"$ref":"#/components/schemas/entity.TransportationType"}},"required":["end","start","transportationType"],"type":"object"},"request.Flight":{"properties":{"legs":{"items":{"$ref":"#/components/schemas/request.FlightLeg"},"type":"array","uniqueItems":false}},"required":["legs"],"type":"object"},"request.FlightLeg":{"properties":{"date":{"example":"2026-01-30","type":"string"},"flightNumber":{"example":"EK412","type":"string"},"originAirport":{"example":"SYD","nullable":true,"type":"string"}},"required":["date","flightNumber","originAirport"],"type":"object"},"request.Train":{"properties":{"departureDate":{"example":"2025-09-20","type":"string"},"fromStationId":{"example":"8011113","type":"string"},"toStationId":{"example":"8000261","type":"string"},"trainNumbers":{"example":["ICE707"],"items":{"type":"string"},"type":"array","uniqueItems":false},"viaStationId":{"example":"8596008","nullable":true,"type":"string"}},"required":["departureDate","fromStationId","toStationId","trainNumbers","viaStationId"],"type":"object"},"response.Error":{"properties":{"detail":{"nullable":true,"type":"string"},"error":{"type":"string"}},"required":["error"],"type":"object"}}},
+    "components": {"schemas":{"entity.Airport":{"properties":{"iata":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"municipality":{"type":"string"},"name":{"type":"string"}},"required":["iata","location","municipality","name"],"type":"object"},"entity.AlertPeriod":{"properties":{"end":{"nullable":true,"type":"string"},"start":{"nullable":true,"type":"string"}},"required":["end","start"],"type":"object"},"entity.AmbiguousFlightChoice":{"properties":{"departureDateTime":{"type":"string"},"destinationIata":{"type":"string"},"originIata":{"type":"string"}},"required":["departureDateTime","destinationIata","originIata"],"type":"object"},"entity.Directions":{"properties":{"geoJson":{"type":"object"},"routes":{"items":{"$ref":"#/components/schemas/entity.Route"},"type":"array","uniqueItems":false}},"required":["geoJson","routes"],"type":"object"},"entity.ElevationPoint":{"properties":{"distanceInMeters":{"type":"number"},"elevationInMeters":{"type":"number"}},"required":["distanceInMeters","elevationInMeters"],"type":"object"},"entity.ErrAmbiguousFlightRequest":{"additionalProperties":{"items":{"$ref":"#/components/schemas/entity.AmbiguousFlightChoice"},"type":"array"},"type":"object"},"entity.Flight":{"properties":{"geoJson":{"type":"object"},"legs":{"items":{"$ref":"#/components/schemas/entity.FlightLeg"},"type":"array","uniqueItems":false}},"required":["geoJson","legs"],"type":"object"},"entity.FlightLeg":{"properties":{"aircraft":{"nullable":true,"type":"string"},"airline":{"type":"string"},"amadeusFlightDate":{"nullable":true,"type":"string"},"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Airport"},"durationInMinutes":{"type":"integer"},"flightNumber":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.Airport"}},"required":["aircraft","airline","amadeusFlightDate","arrivalDateTime","departureDateTime","destination","durationInMinutes","flightNumber","origin"],"type":"object"},"entity.GeocodeAddress":{"properties":{"country":{"nullable":true,"type":"string"},"countryCode":{"example":"DE","nullable":true,"type":"string"},"houseNumber":{"nullable":true,"type":"string"},"locality":{"nullable":true,"type":"string"},"postalCode":{"nullable":true,"type":"string"},"region":{"nullable":true,"type":"string"},"street":{"nullable":true,"type":"string"}},"required":["country","countryCode","houseNumber","locality","postalCode","region","street"],"type":"object"},"entity.GeocodePlace":{"properties":{"address":{"$ref":"#/components/schemas/entity.GeocodeAddress"},"boundingBox":{"items":{"type":"number"},"nullable":true,"type":"array","uniqueItems":false},"distanceInKilometers":{"nullable":true,"type":"number"},"label":{"type":"string"},"latitude":{"type":"number"},"layer":{"example":"venue","type":"string"},"longitude":{"type":"number"},"name":{"type":"string"}},"required":["address","boundingBox","distanceInKilometers","label","latitude","layer","longitude","name"],"type":"object"},"entity.LiveEvent":{"properties":{"changes":{"items":{"$ref":"#/components/schemas/entity.WatchChange"},"type":"array","uniqueItems":false},"error":{"type":"string"},"id":{"example":"1760881379.42","type":"string"},"legs":{"items":{"$ref":"#/components/schemas/entity.WatchLeg"},"type":"array","uniqueItems":false},"occurredAt":{"type":"string"},"subject":{"example":"flight:EK412:2026-01-30","type":"string"},"type":{"$ref":"#/components/schemas/entity.LiveEventType"}},"required":["changes","error","id","legs","occurredAt","subject","type"],"type":"object"},"entity.LiveEventType":{"example":"changed","type":"string","x-enum-varnames":["LiveSnapshot","LiveChanged","LiveFailed"]},"entity.Location":{"properties":{"latitude":{"type":"number"},"longitude":{"type":"number"}},"required":["latitude","longitude"],"type":"object"},"entity.Matrix":{"properties":{"distancesInMeters":{"items":{"items":{"type":"number"},"type":"array"},"type":"array","uniqueItems":false},"durationsInSeconds":{"items":{"items":{"type":"number"},"type":"array"},"type":"array","uniqueItems":false},"order":{"example":[0,2,1],"items":{"type":"integer"},"nullable":true,"type":"array","uniqueItems":false}},"required":["distancesInMeters","durationsInSeconds","order"],"type":"object"},"entity.Poi":{"properties":{"category":{"example":"restaurant","type":"string"},"distanceInMeters":{"type":"number"},"id":{"example":"node/240109189","type":"string"},"latitude":{"type":"number"},"longitude":{"type":"number"},"name":{"type":"string"},"openingHours":{"example":"Mo-Fr 11:00-22:00","nullable":true,"type":"string"}},"required":["category","distanceInMeters","id","latitude","longitude","name","openingHours"],"type":"object"},"entity.PushSubscription":{"properties":{"endpoint":{"example":"https://fcm.googleapis.com/fcm/send/dpH5...","maxLength":2048,"type":"string"},"keys":{"$ref":"#/components/schemas/entity.PushSubscriptionKeys"}},"required":["endpoint","keys"],"type":"object"},"entity.PushSubscriptionKeys":{"properties":{"auth":{"example":"tBHItJI5svbpez7KI4CCXg","maxLength":64,"type":"string"},"p256dh":{"description":"P256dh is the public key of the user agent, Auth its authentication\nsecret, both encoded as unpadded base64url.","example":"BNcRdreALRFXTkOOUHK1EtK2wtaz5Ry4YfYCA_0QTpQtUbVlUls0VJXg7A8u-Ts1XbjhazAkj7I99e8QcYP7DkM","maxLength":128,"type":"string"}},"required":["auth","p256dh"],"type":"object"},"entity.RoadTrip":{"properties":{"distanceInMeters":{"type":"number"},"durationInSeconds":{"type":"number"},"stages":{"items":{"$ref":"#/components/schemas/entity.RoadTripStage"},"type":"array","uniqueItems":false}},"required":["distanceInMeters","durationInSeconds","stages"],"type":"object"},"entity.RoadTripStage":{"properties":{"day":{"example":1,"type":"integer"},"distanceInMeters":{"type":"number"},"durationInSeconds":{"type":"number"},"end":{"$ref":"#/components/schemas/entity.Location"},"geoJson":{"type":"object"},"overnight":{"$ref":"#/components/schemas/entity.GeocodePlace"},"start":{"$ref":"#/components/schemas/entity.Location"}},"required":["day","distanceInMeters","durationInSeconds","end","geoJson","start"],"type":"object"},"entity.Route":{"properties":{"ascentInMeters":{"nullable":true,"type":"number"},"descentInMeters":{"nullable":true,"type":"number"},"distanceInMeters":{"type":"number"},"durationInSeconds":{"type":"number"},"elevationProfile":{"items":{"$ref":"#/components/schemas/entity.ElevationPoint"},"type":"array","uniqueItems":false},"steps":{"items":{"$ref":"#/components/schemas/entity.RouteStep"},"type":"array","uniqueItems":false}},"required":["ascentInMeters","descentInMeters","distanceInMeters","durationInSeconds","elevationProfile","steps"],"type":"object"},"entity.RouteStep":{"properties":{"distanceInMeters":{"type":"number"},"durationInSeconds":{"type":"number"},"instruction":{"example":"Turn left onto Unter den Linden","type":"string"},"name":{"type":"string"}},"required":["distanceInMeters","durationInSeconds","instruction","name"],"type":"object"},"entity.ServiceAlert":{"properties":{"activePeriods":{"items":{"$ref":"#/components/schemas/entity.AlertPeriod"},"type":"array","uniqueItems":false},"cause":{"example":"STRIKE","type":"string"},"description":{"type":"string"},"effect":{"example":"SIGNIFICANT_DELAYS","type":"string"},"feed":{"type":"string"},"header":{"type":"string"},"id":{"type":"string"},"routeIds":{"items":{"type":"string"},"type":"array","uniqueItems":false},"stopIds":{"items":{"type":"string"},"type":"array","uniqueItems":false},"tripIds":{"items":{"type":"string"},"type":"array","uniqueItems":false},"url":{"nullable":true,"type":"string"}},"required":["activePeriods","cause","description","effect","feed","header","id","routeIds","stopIds","tripIds","url"],"type":"object"},"entity.Train":{"properties":{"geoJson":{"type":"object"},"legs":{"items":{"$ref":"#/components/schemas/entity.TrainLeg"},"type":"array","uniqueItems":false},"refreshToken":{"type":"string"},"transfers":{"items":{"$ref":"#/components/schemas/entity.TrainTransfer"},"type":"array","uniqueItems":false}},"required":["geoJson","legs","refreshToken","transfers"],"type":"object"},"entity.TrainLeg":{"properties":{"arrivalDateTime":{"type":"string"},"arrivalPlatform":{"nullable":true,"type":"string"},"cancelled":{"type":"boolean"},"departureDateTime":{"type":"string"},"departurePlatform":{"nullable":true,"type":"string"},"destination":{"$ref":"#/components/schemas/entity.TrainStation"},"durationInMinutes":{"type":"integer"},"lineName":{"type":"string"},"operatorName":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.TrainStation"},"realtimeArrivalDateTime":{"nullable":true,"type":"string"},"realtimeDepartureDateTime":{"nullable":true,"type":"string"},"stopovers":{"items":{"$ref":"#/components/schemas/entity.TrainStopover"},"type":"array","uniqueItems":false},"tripId":{"type":"string"}},"required":["arrivalDateTime","arrivalPlatform","cancelled","departureDateTime","departurePlatform","destination","durationInMinutes","lineName","operatorName","origin","realtimeArrivalDateTime","realtimeDepartureDateTime","stopovers","tripId"],"type":"object"},"entity.TrainStation":{"properties":{"id":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"}},"required":["id","location","name"],"type":"object"},"entity.TrainStopover":{"properties":{"arrivalDateTime":{"nullable":true,"type":"string"},"cancelled":{"type":"boolean"},"departureDateTime":{"nullable":true,"type":"string"},"platform":{"nullable":true,"type":"string"},"realtimeArrivalDateTime":{"nullable":true,"type":"string"},"realtimeDepartureDateTime":{"nullable":true,"type":"string"},"station":{"$ref":"#/components/schemas/entity.TrainStation"}},"required":["arrivalDateTime","cancelled","departureDateTime","platform","realtimeArrivalDateTime","realtimeDepartureDateTime","station"],"type":"object"},"entity.TrainTransfer":{"properties":{"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.TrainStation"},"distanceInMeters":{"nullable":true,"type":"integer"},"durationInMinutes":{"type":"integer"},"geometry":{"nullable":true,"type":"object"},"origin":{"$ref":"#/components/schemas/entity.TrainStation"},"type":{"$ref":"#/components/schemas/entity.TrainTransferType"}},"required":["arrivalDateTime","departureDateTime","destination","distanceInMeters","durationInMinutes","geometry","origin","type"],"type":"object"},"entity.TrainTransferType":{"type":"string","x-enum-varnames":["WALKING","TRANSFER"]},"entity.TrainTrip":{"properties":{"id":{"type":"string"},"lineName":{"type":"string"},"operatorName":{"type":"string"},"stopovers":{"items":{"$ref":"#/components/schemas/entity.TrainStopover"},"type":"array","uniqueItems":false}},"required":["id","lineName","operatorName","stopovers"],"type":"object"},"entity.Transit":{"properties":{"geoJson":{"type":"object"},"legs":{"items":{"$ref":"#/components/schemas/entity.TransitLeg"},"type":"array","uniqueItems":false}},"required":["geoJson","legs"],"type":"object"},"entity.TransitLeg":{"properties":{"agencyName":{"type":"string"},"arrivalDateTime":{"type":"string"},"cancelled":{"type":"boolean"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.TransitStop"},"durationInMinutes":{"type":"integer"},"feed":{"type":"string"},"headsign":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.TransitStop"},"realtimeArrivalDateTime":{"nullable":true,"type":"string"},"realtimeDepartureDateTime":{"nullable":true,"type":"string"},"routeId":{"type":"string"},"routeName":{"type":"string"},"stopovers":{"items":{"$ref":"#/components/schemas/entity.TransitStopover"},"type":"array","uniqueItems":false},"timezone":{"type":"string"},"tripId":{"type":"string"},"type":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]},"vehicle":{"$ref":"#/components/schemas/entity.VehiclePosition"}},"required":["agencyName","arrivalDateTime","cancelled","departureDateTime","destination","durationInMinutes","feed","headsign","origin","realtimeArrivalDateTime","realtimeDepartureDateTime","routeId","routeName","stopovers","timezone","tripId","type"],"type":"object"},"entity.TransitStop":{"properties":{"feed":{"type":"string"},"id":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"}},"required":["feed","id","location","name"],"type":"object"},"entity.TransitStopover":{"properties":{"arrivalDateTime":{"type":"string"},"cancelled":{"type":"boolean"},"departureDateTime":{"type":"string"},"realtimeArrivalDateTime":{"nullable":true,"type":"string"},"realtimeDepartureDateTime":{"nullable":true,"type":"string"},"stop":{"$ref":"#/components/schemas/entity.TransitStop"},"stopSequence":{"type":"integer"}},"required":["arrivalDateTime","cancelled","departureDateTime","realtimeArrivalDateTime","realtimeDepartureDateTime","stop","stopSequence"],"type":"object"},"entity.TransportationType":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]},"entity.VapidKey":{"properties":{"publicKey":{"description":"PublicKey is the application server key for PushManager.subscribe(),\nencoded as unpadded base64url.","example":"BEl62iUYgUivxIkv69yViEuiBIa-Ib9-SkvMeAtA3LFgDzkrxZJjSgSnfckjBJuBkr3qBUYIHBQFLXYp5Nksh8U","type":"string"}},"required":["publicKey"],"type":"object"},"entity.VehiclePosition":{"properties":{"bearing":{"nullable":true,"type":"number"},"location":{"$ref":"#/components/schemas/entity.Location"},"stopId":{"nullable":true,"type":"string"},"timestamp":{"type":"string"},"tripId":{"type":"string"}},"required":["bearing","location","stopId","timestamp","tripId"],"type":"object"},"entity.Watch":{"properties":{"checkedAt":{"type":"string"},"createdAt":{"type":"string"},"flight":{"$ref":"#/components/schemas/entity.WatchedFlight"},"id":{"type":"string"},"legs":{"items":{"$ref":"#/components/schemas/entity.WatchLeg"},"type":"array","uniqueItems":false},"nextCheckAt":{"type":"string"},"pushSubscription":{"$ref":"#/components/schemas/entity.PushSubscription"},"subscriberKey":{"type":"string"},"train":{"$ref":"#/components/schemas/entity.WatchedTrain"},"webhookUrl":{"type":"string"}},"required":["checkedAt","createdAt","id","legs","nextCheckAt","subscriberKey","webhookUrl"],"type":"object"},"entity.WatchChange":{"properties":{"current":{"nullable":true,"type":"string"},"field":{"example":"realtimeDepartureDateTime","type":"string"},"leg":{"type":"integer"},"previous":{"nullable":true,"type":"string"}},"required":["current","field","leg","previous"],"type":"object"},"entity.WatchLeg":{"properties":{"aircraft":{"nullable":true,"type":"string"},"arrivalDateTime":{"type":"string"},"arrivalPlatform":{"nullable":true,"type":"string"},"cancelled":{"type":"boolean"},"departureDateTime":{"type":"string"},"departurePlatform":{"nullable":true,"type":"string"},"destination":{"type":"string"},"origin":{"type":"string"},"realtimeArrivalDateTime":{"nullable":true,"type":"string"},"realtimeDepartureDateTime":{"nullable":true,"type":"string"}},"required":["aircraft","arrivalDateTime","arrivalPlatform","cancelled","departureDateTime","departurePlatform","destination","origin","realtimeArrivalDateTime","realtimeDepartureDateTime"],"type":"object"},"entity.WatchedFlight":{"properties":{"date":{"example":"2026-01-30","type":"string"},"flightNumber":{"example":"EK412","type":"string"},"originAirport":{"example":"SYD","nullable":true,"type":"string"}},"required":["date","flightNumber","originAirport"],"type":"object"},"entity.WatchedTrain":{"properties":{"departureDate":{"example":"2025-09-20","type":"string"},"fromStationId":{"example":"8011113","type":"string"},"provider":{"example":"oebb","nullable":true,"type":"string"},"toStationId":{"example":"8000261","type":"string"},"trainNumbers":{"example":["ICE707"],"items":{"type":"string"},"type":"array","uniqueItems":false},"viaStationId":{"example":"8596008","nullable":true,"type":"string"}},"required":["departureDate","fromStationId","provider","toStationId","trainNumbers","viaStationId"],"type":"object"},"request.Autocomplete":{"properties":{"countries":{"example":["DE","AT"],"items":{"type":"string"},"type":"array","uniqueItems":false},"focus":{"$ref":"#/components/schemas/entity.Location"},"layers":{"example":["venue","address"],"items":{"type":"string"},"type":"array","uniqueItems":false},"size":{"maximum":40,"minimum":1,"nullable":true,"type":"integer"},"text":{"example":"Brandenburger Tor","type":"string"}},"required":["countries","layers","size","text"],"type":"object"},"request.Batch":{"properties":{"items":{"items":{"$ref":"#/components/schemas/request.BatchItem"},"maxItems":100,"minItems":1,"type":"array","uniqueItems":true}},"required":["items"],"type":"object"},"request.BatchItem":{"properties":{"flight":{"$ref":"#/components/schemas/request.Flight"},"id":{"example":"leg-1","maxLength":64,"type":"string"},"train":{"$ref":"#/components/schemas/request.Train"},"trainTrip":{"$ref":"#/components/schemas/request.TrainTrip"},"trainTripSection":{"$ref":"#/components/schemas/request.TrainTripSection"}},"required":["id"],"type":"object"},"request.Directions":{"properties":{"alternatives":{"maximum":3,"minimum":1,"nullable":true,"type":"integer"},"avoid":{"example":["tolls","ferries"],"items":{"type":"string"},"type":"array","uniqueItems":false},"end":{"$ref":"#/components/schemas/entity.Location"},"language":{"example":"de","nullable":true,"type":"string"},"start":{"$ref":"#/components/schemas/entity.Location"},"transportationType":{"$ref":"#/components/schemas/entity.TransportationType"},"units":{"enum":["m","km","mi"],"example":"km","nullable":true,"type":"string"},"waypoints":{"items":{"$ref":"#/components/schemas/entity.Location"},"maxItems":48,"type":"array","uniqueItems":false}},"required":["alternatives","avoid","end","language","start","transportationType","units","waypoints"],"type":"object"},"request.Flight":{"properties":{"legs":{"items":{"$ref":"#/components/schemas/request.FlightLeg"},"maxItems":16,"minItems":1,"type":"array","uniqueItems":false}},"required":["legs"],"type":"object"},"request.FlightLeg":{"properties":{"date":{"example":"2026-01-30","type":"string"},"flightNumber":{"example":"EK412","type":"string"},"originAirport":{"example":"SYD","nullable":true,"type":"string"}},"required":["date","flightNumber","originAirport"],"type":"object"},"request.Isochrones":{"properties":{"locations":{"items":{"$ref":"#/components/schemas/entity.Location"},"maxItems":5,"minItems":1,"type":"array","uniqueItems":false},"rangeType":{"enum":["time","distance"],"example":"time","type":"string"},"ranges":{"example":[600,1200],"items":{"type":"integer"},"maxItems":10,"minItems":1,"type":"array","uniqueItems":false},"transportationType":{"example":"BIKE","type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]}},"required":["locations","rangeType","ranges","transportationType"],"type":"object"},"request.Matrix":{"properties":{"locations":{"items":{"$ref":"#/components/schemas/entity.Location"},"maxItems":50,"minItems":2,"type":"array","uniqueItems":false},"optimize":{"type":"boolean"},"transportationType":{"example":"HIKE","type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]}},"required":["locations","optimize","transportationType"],"type":"object"},"request.Pois":{"properties":{"boundingBox":{"items":{"type":"number"},"nullable":true,"type":"array","uniqueItems":false},"categories":{"example":["restaurant","pharmacy"],"items":{"type":"string"},"type":"array","uniqueItems":false},"location":{"$ref":"#/components/schemas/entity.Location"},"radius":{"example":500,"maximum":2000,"minimum":1,"nullable":true,"type":"integer"},"size":{"maximum":100,"minimum":1,"nullable":true,"type":"integer"}},"required":["boundingBox","categories","location","radius","size"],"type":"object"},"request.Push":{"properties":{"notification":{"description":"Notification is sent as is, so its JSON must fit into a push message.","type":"object"},"subscription":{"$ref":"#/components/schemas/entity.PushSubscription"},"topic":{"example":"EK412","maxLength":32,"nullable":true,"type":"string"},"ttl":{"example":3600,"maximum":2419200,"minimum":0,"nullable":true,"type":"integer"},"urgency":{"enum":["very-low","low","normal","high"],"example":"high","nullable":true,"type":"string"}},"required":["notification","subscription","topic","ttl","urgency"],"type":"object"},"request.RoadTrip":{"properties":{"avoid":{"example":["tolls","ferries"],"items":{"type":"string"},"type":"array","uniqueItems":false},"end":{"$ref":"#/components/schemas/entity.Location"},"language":{"example":"de","nullable":true,"type":"string"},"maxDailyDrivingMinutes":{"example":360,"maximum":1440,"minimum":60,"type":"integer"},"start":{"$ref":"#/components/schemas/entity.Location"},"transportationType":{"example":"CAR","type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]},"waypoints":{"items":{"$ref":"#/components/schemas/entity.Location"},"maxItems":48,"type":"array","uniqueItems":false}},"required":["avoid","end","language","maxDailyDrivingMinutes","start","transportationType","waypoints"],"type":"object"},"request.Train":{"properties":{"departureDate":{"example":"2025-09-20","type":"string"},"fromStationId":{"example":"8011113","type":"string"},"provider":{"example":"oebb","maxLength":32,"nullable":true,"type":"string"},"toStationId":{"example":"8000261","type":"string"},"trainNumbers":{"example":["ICE707"],"items":{"type":"string"},"maxItems":8,"minItems":1,"type":"array","uniqueItems":false},"viaStationId":{"example":"8596008","nullable":true,"type":"string"}},"required":["departureDate","fromStationId","provider","toStationId","trainNumbers","viaStationId"],"type":"object"},"request.TrainTrip":{"properties":{"departureDate":{"example":"2025-09-20","type":"string"},"trainNumber":{"example":"ICE 707","maxLength":32,"type":"string"}},"required":["departureDate","trainNumber"],"type":"object"},"request.TrainTripSection":{"properties":{"departureDate":{"example":"2025-09-20","type":"string"},"fromStationId":{"example":"8011113","type":"string"},"toStationId":{"example":"8000261","type":"string"},"trainNumber":{"example":"ICE 707","maxLength":32,"type":"string"}},"required":["departureDate","fromStationId","toStationId","trainNumber"],"type":"object"},"request.Transit":{"properties":{"legs":{"items":{"$ref":"#/components/schemas/request.TransitLeg"},"maxItems":16,"minItems":1,"type":"array","uniqueItems":false}},"required":["legs"],"type":"object"},"request.TransitLeg":{"properties":{"date":{"example":"2026-07-14","type":"string"},"feed":{"example":"flixbus","type":"string"},"fromStopId":{"example":"dcc1e8a8-9603-11e6-9066-549f350fcb0c","type":"string"},"toStopId":{"example":"dcbb5de2-9603-11e6-9066-549f350fcb0c","type":"string"},"tripId":{"example":"N1001-1-1068012023-ZZ","type":"string"}},"required":["date","feed","fromStopId","toStopId","tripId"],"type":"object"},"request.TransitTrips":{"properties":{"date":{"example":"2026-07-14","type":"string"},"feed":{"example":"flixbus","nullable":true,"type":"string"},"fromStopId":{"example":"dcc1e8a8-9603-11e6-9066-549f350fcb0c","nullable":true,"type":"string"},"routeShortName":{"example":"N1001","type":"string"},"toStopId":{"example":"dcbb5de2-9603-11e6-9066-549f350fcb0c","nullable":true,"type":"string"}},"required":["date","feed","fromStopId","routeShortName","toStopId"],"type":"object"},"request.Watch":{"properties":{"flight":{"$ref":"#/components/schemas/request.FlightLeg"},"pushSubscription":{"$ref":"#/components/schemas/entity.PushSubscription"},"subscriberKey":{"example":"user-42","maxLength":128,"type":"string"},"train":{"$ref":"#/components/schemas/request.Train"},"webhookUrl":{"example":"https://worker.example.com/webhooks/kompass","maxLength":2048,"type":"string"}},"required":["subscriberKey","webhookUrl"],"type":"object"},"response.BatchResult":{"properties":{"error":{"$ref":"#/components/schemas/response.Error"},"flight":{"$ref":"#/components/schemas/entity.Flight"},"id":{"type":"string"},"train":{"$ref":"#/components/schemas/entity.Train"},"trainTrip":{"$ref":"#/components/schemas/entity.TrainTrip"}},"required":["id"],"type":"object"},"response.Error":{"properties":{"code":{"example":"NOT_FOUND","type":"string"},"detail":{"example":"no matching flight found","type":"string"},"instance":{"example":"/api/v1/flights","type":"string"},"invalidParams":{"items":{"$ref":"#/components/schemas/response.InvalidParam"},"type":"array","uniqueItems":false},"requestId":{"example":"5f0c3a4e-9a4b-4c1e-8d0e-6a2b1f3c4d5e","type":"string"},"status":{"example":404,"type":"integer"},"title":{"example":"Not Found","type":"string"},"type":{"example":"urn:kompass:problem:not-found","type":"string"}},"required":["code","detail","instance","requestId","status","title","type"],"type":"object"},"response.InvalidParam":{"properties":{"name":{"example":"departureDate","type":"string"},"reason":{"example":"is required","type":"string"}},"required":["name","reason"],"type":"object"}}},
     "info": {"description":"{{escape .Description}}","title":"{{.Title}}","version":"{{.Version}}"},
     "externalDocs": {"description":"","url":""},
-    "paths": {"/flights":{"post":{"operationId":"postFlight","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Flight"}}},"description":"flight","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Flight"}}},"description":"OK"},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.ErrAmbiguousFlightRequest"}}},"description":"Unprocessable Entity"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Find flight","tags":["flights"]}},"/geocoding/directions":{"post":{"operationId":"lookupDirections","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Directions"}}},"description":"directions request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"type":"object"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup directions","tags":["geocoding"]}},"/geocoding/location":{"post":{"operationId":"lookupLocation","parameters":[{"description":"location query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Location"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup location","tags":["geocoding"]}},"/geocoding/station":{"post":{"operationId":"lookupTrainStation","parameters":[{"description":"station query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TrainStation"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup train station","tags":["geocoding"]}},"/trains":{"post":{"operationId":"postTrainJourney","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Train"}}},"description":"train journey","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Train"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Find train journey","tags":["trains"]}}},
+    "paths": {"/batch":{"post":{"description":"Items are validated and processed independently. Each result has the item's ID and either its result or a problem details error.","operationId":"postBatch","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Batch"}}},"description":"batch","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/response.BatchResult"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Look up flights and trains in a batch","tags":["batch"]}},"/flights":{"post":{"operationId":"postFlight","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Flight"}}},"description":"flight","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Flight"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.ErrAmbiguousFlightRequest"}}},"description":"Unprocessable Entity"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"},"502":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Gateway"},"503":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Service Unavailable"},"504":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Gateway Timeout"}},"summary":"Find flight","tags":["flights"]}},"/geocoding/autocomplete":{"post":{"operationId":"autocompleteLocation","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Autocomplete"}}},"description":"autocomplete request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.GeocodePlace"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Autocomplete location","tags":["geocoding"]}},"/geocoding/directions":{"post":{"operationId":"lookupDirections","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Directions"}}},"description":"directions request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Directions"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup directions","tags":["geocoding"]}},"/geocoding/isochrones":{"post":{"operationId":"lookupIsochrones","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Isochrones"}}},"description":"isochrones request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"type":"object"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup isochrones","tags":["geocoding"]}},"/geocoding/location":{"post":{"operationId":"lookupLocation","parameters":[{"description":"location query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Location"}}},"description":"OK"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup location","tags":["geocoding"]}},"/geocoding/matrix":{"post":{"operationId":"lookupMatrix","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Matrix"}}},"description":"matrix request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Matrix"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup travel time matrix","tags":["geocoding"]}},"/geocoding/pois":{"post":{"operationId":"searchPois","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Pois"}}},"description":"poi search request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Poi"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Search points of interest","tags":["geocoding"]}},"/geocoding/reverse":{"get":{"operationId":"reverseGeocode","parameters":[{"description":"latitude","in":"query","name":"lat","required":true,"schema":{"type":"number"}},{"description":"longitude","in":"query","name":"lon","required":true,"schema":{"type":"number"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.GeocodePlace"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Reverse geocode location","tags":["geocoding"]}},"/geocoding/roadtrip":{"post":{"operationId":"planRoadTrip","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.RoadTrip"}}},"description":"road trip request","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.RoadTrip"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Plan road trip","tags":["geocoding"]}},"/geocoding/station":{"post":{"operationId":"lookupTrainStation","parameters":[{"description":"station query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TrainStation"}}},"description":"OK"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup train station","tags":["geocoding"]}},"/live":{"get":{"description":"Server-Sent Events of the given flights and trains. Each subject is sent as a snapshot event first, then as changed events when delays, platforms, aircraft or cancellations change. Flights are followed by their schedules, which carry no gates or realtime delays, so flights only change with their scheduled times or aircraft. Streams are resumed with the Last-Event-ID header. Comments are sent as heartbeats.","operationId":"streamLive","parameters":[{"description":"flights as flightNumber:date[:originAirport], e.g. EK412:2026-01-30","in":"query","name":"flight","schema":{"items":{"type":"string"},"type":"array"},"style":"form"},{"description":"train journeys as fromStationId:toStationId:departureDate:trainNumber[,trainNumber], e.g. 8011113:8000261:2025-09-20:ICE707","in":"query","name":"train","schema":{"items":{"type":"string"},"type":"array"},"style":"form"},{"description":"ID of the last received event","in":"header","name":"Last-Event-ID","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.LiveEvent"}},"text/event-stream":{"schema":{"type":"string"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Stream live updates","tags":["live"]}},"/push":{"post":{"description":"Sends the notification as encrypted Web Push message. Subscriptions which expired are reported with status 410 and should be removed.","operationId":"sendPush","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Push"}}},"description":"push notification","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"410":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Gone"},"501":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Implemented"},"502":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Gateway"}},"summary":"Send push notification","tags":["push"]}},"/push/key":{"get":{"description":"The application server key to subscribe to push messages with.","operationId":"retrieveVapidKey","responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.VapidKey"}}},"description":"OK"},"501":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Implemented"}},"summary":"Retrieve VAPID key","tags":["push"]}},"/trains":{"post":{"operationId":"postTrainJourney","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Train"}}},"description":"train journey","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Train"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"},"502":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Gateway"},"503":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Service Unavailable"},"504":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Gateway Timeout"}},"summary":"Find train journey","tags":["trains"]}},"/trains/trip":{"post":{"operationId":"postTrainTrip","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.TrainTrip"}}},"description":"train trip","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TrainTrip"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"},"502":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Gateway"},"503":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Service Unavailable"},"504":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Gateway Timeout"}},"summary":"Find train trip by train number","tags":["trains"]}},"/trains/trip/section":{"post":{"operationId":"postTrainTripSection","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.TrainTripSection"}}},"description":"train trip section","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Train"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"},"502":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Gateway"},"503":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Service Unavailable"},"504":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Gateway Timeout"}},"summary":"Find train journey from a section of a train trip","tags":["trains"]}},"/transit":{"post":{"operationId":"postTransit","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Transit"}}},"description":"transit","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transit"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Find transit","tags":["transit"]}},"/transit/alerts":{"post":{"operationId":"lookupTransitAlerts","parameters":[{"description":"GTFS feed","in":"query","name":"feed","schema":{"type":"string"}},{"description":"route id","in":"query","name":"routeId","schema":{"type":"string"}},{"description":"stop id","in":"query","name":"stopId","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.ServiceAlert"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup active service alerts","tags":["transit"]}},"/transit/stops":{"post":{"operationId":"lookupTransitStops","parameters":[{"description":"stop query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.TransitStop"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Lookup transit stops","tags":["transit"]}},"/transit/trips":{"post":{"operationId":"postTransitTrips","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.TransitTrips"}}},"description":"transit trips","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.TransitLeg"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Find transit trips by route and date","tags":["transit"]}},"/watches":{"get":{"operationId":"listWatches","parameters":[{"description":"subscriber key","in":"query","name":"subscriberKey","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Watch"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"List watches","tags":["watches"]},"post":{"description":"Flights only change with their scheduled times or aircraft, as their schedules carry no gates or realtime delays. Changes are posted as signed watch.changed events to the webhook URL until the journey arrived, followed by a watch.expired event. Webhook URLs must use https and point to a public address, and are only accepted if a webhook secret is configured, push subscriptions only if a VAPID key is.","operationId":"createWatch","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Watch"}}},"description":"watch","required":true},"responses":{"201":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Watch"}}},"description":"Created"},"400":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"},"501":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Implemented"}},"summary":"Watch flight or train","tags":["watches"]}},"/watches/{id}":{"delete":{"operationId":"deleteWatch","parameters":[{"description":"watch ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Delete watch","tags":["watches"]},"get":{"operationId":"retrieveWatch","parameters":[{"description":"watch ID","in":"path","name":"id","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Watch"}}},"description":"OK"},"404":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/problem+json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"summary":"Retrieve watch","tags":["watches"]}}},
     "openapi": "3.1.0",
     "servers": [
         {"url":"http://127.0.0.1:8080/api/v1"}
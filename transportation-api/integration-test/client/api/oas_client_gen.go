@@ -4,11 +4,11 @@ package api
 
 import (
 	"context"
+	"io"
 	"net/url"
 	"strings"
 
 	"github.com/go-faster/errors"
-
 	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/uri"
@@ -21,24 +21,89 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AutocompleteLocation invokes autocompleteLocation operation.
+	//
+	// Autocomplete location.
+	//
+	// POST /geocoding/autocomplete
+	AutocompleteLocation(ctx context.Context, request *RequestAutocomplete) (AutocompleteLocationRes, error)
+	// CreateWatch invokes createWatch operation.
+	//
+	// Flights only change with their scheduled times or aircraft, as their schedules carry no gates or
+	// realtime delays. Changes are posted as signed watch.changed events to the webhook URL until the
+	// journey arrived, followed by a watch.expired event. Webhook URLs must use https and point to a
+	// public address, and are only accepted if a webhook secret is configured, push subscriptions only if
+	// a VAPID key is.
+	//
+	// POST /watches
+	CreateWatch(ctx context.Context, request *RequestWatch) (CreateWatchRes, error)
+	// DeleteWatch invokes deleteWatch operation.
+	//
+	// Delete watch.
+	//
+	// DELETE /watches/{id}
+	DeleteWatch(ctx context.Context, params DeleteWatchParams) (DeleteWatchRes, error)
+	// ListWatches invokes listWatches operation.
+	//
+	// List watches.
+	//
+	// GET /watches
+	ListWatches(ctx context.Context, params ListWatchesParams) (ListWatchesRes, error)
 	// LookupDirections invokes lookupDirections operation.
 	//
 	// Lookup directions.
 	//
 	// POST /geocoding/directions
 	LookupDirections(ctx context.Context, request *RequestDirections) (LookupDirectionsRes, error)
+	// LookupIsochrones invokes lookupIsochrones operation.
+	//
+	// Lookup isochrones.
+	//
+	// POST /geocoding/isochrones
+	LookupIsochrones(ctx context.Context, request *RequestIsochrones) (LookupIsochronesRes, error)
 	// LookupLocation invokes lookupLocation operation.
 	//
 	// Lookup location.
 	//
 	// POST /geocoding/location
 	LookupLocation(ctx context.Context, params LookupLocationParams) (LookupLocationRes, error)
+	// LookupMatrix invokes lookupMatrix operation.
+	//
+	// Lookup travel time matrix.
+	//
+	// POST /geocoding/matrix
+	LookupMatrix(ctx context.Context, request *RequestMatrix) (LookupMatrixRes, error)
 	// LookupTrainStation invokes lookupTrainStation operation.
 	//
 	// Lookup train station.
 	//
 	// POST /geocoding/station
 	LookupTrainStation(ctx context.Context, params LookupTrainStationParams) (LookupTrainStationRes, error)
+	// LookupTransitAlerts invokes lookupTransitAlerts operation.
+	//
+	// Lookup active service alerts.
+	//
+	// POST /transit/alerts
+	LookupTransitAlerts(ctx context.Context, params LookupTransitAlertsParams) (LookupTransitAlertsRes, error)
+	// LookupTransitStops invokes lookupTransitStops operation.
+	//
+	// Lookup transit stops.
+	//
+	// POST /transit/stops
+	LookupTransitStops(ctx context.Context, params LookupTransitStopsParams) (LookupTransitStopsRes, error)
+	// PlanRoadTrip invokes planRoadTrip operation.
+	//
+	// Plan road trip.
+	//
+	// POST /geocoding/roadtrip
+	PlanRoadTrip(ctx context.Context, request *RequestRoadTrip) (PlanRoadTripRes, error)
+	// PostBatch invokes postBatch operation.
+	//
+	// Items are validated and processed independently. Each result has the item's ID and either its result
+	// or a problem details error.
+	//
+	// POST /batch
+	PostBatch(ctx context.Context, request *RequestBatch) (PostBatchRes, error)
 	// PostFlight invokes postFlight operation.
 	//
 	// Find flight.
@@ -51,6 +116,71 @@ type Invoker interface {
 	//
 	// POST /trains
 	PostTrainJourney(ctx context.Context, request *RequestTrain) (PostTrainJourneyRes, error)
+	// PostTrainTrip invokes postTrainTrip operation.
+	//
+	// Find train trip by train number.
+	//
+	// POST /trains/trip
+	PostTrainTrip(ctx context.Context, request *RequestTrainTrip) (PostTrainTripRes, error)
+	// PostTrainTripSection invokes postTrainTripSection operation.
+	//
+	// Find train journey from a section of a train trip.
+	//
+	// POST /trains/trip/section
+	PostTrainTripSection(ctx context.Context, request *RequestTrainTripSection) (PostTrainTripSectionRes, error)
+	// PostTransit invokes postTransit operation.
+	//
+	// Find transit.
+	//
+	// POST /transit
+	PostTransit(ctx context.Context, request *RequestTransit) (PostTransitRes, error)
+	// PostTransitTrips invokes postTransitTrips operation.
+	//
+	// Find transit trips by route and date.
+	//
+	// POST /transit/trips
+	PostTransitTrips(ctx context.Context, request *RequestTransitTrips) (PostTransitTripsRes, error)
+	// RetrieveVapidKey invokes retrieveVapidKey operation.
+	//
+	// The application server key to subscribe to push messages with.
+	//
+	// GET /push/key
+	RetrieveVapidKey(ctx context.Context) (RetrieveVapidKeyRes, error)
+	// RetrieveWatch invokes retrieveWatch operation.
+	//
+	// Retrieve watch.
+	//
+	// GET /watches/{id}
+	RetrieveWatch(ctx context.Context, params RetrieveWatchParams) (RetrieveWatchRes, error)
+	// ReverseGeocode invokes reverseGeocode operation.
+	//
+	// Reverse geocode location.
+	//
+	// GET /geocoding/reverse
+	ReverseGeocode(ctx context.Context, params ReverseGeocodeParams) (ReverseGeocodeRes, error)
+	// SearchPois invokes searchPois operation.
+	//
+	// Search points of interest.
+	//
+	// POST /geocoding/pois
+	SearchPois(ctx context.Context, request *RequestPois) (SearchPoisRes, error)
+	// SendPush invokes sendPush operation.
+	//
+	// Sends the notification as encrypted Web Push message. Subscriptions which expired are reported with
+	// status 410 and should be removed.
+	//
+	// POST /push
+	SendPush(ctx context.Context, request *RequestPush) (SendPushRes, error)
+	// StreamLive invokes streamLive operation.
+	//
+	// Server-Sent Events of the given flights and trains. Each subject is sent as a snapshot event first,
+	// then as changed events when delays, platforms, aircraft or cancellations change. Flights are
+	// followed by their schedules, which carry no gates or realtime delays, so flights only change with
+	// their scheduled times or aircraft. Streams are resumed with the Last-Event-ID header. Comments are
+	// sent as heartbeats.
+	//
+	// GET /live
+	StreamLive(ctx context.Context, params StreamLiveParams) (StreamLiveRes, error)
 }
 
 // Client implements OAS client.
@@ -92,28 +222,28 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// LookupDirections invokes lookupDirections operation.
+// AutocompleteLocation invokes autocompleteLocation operation.
 //
-// Lookup directions.
+// Autocomplete location.
 //
-// POST /geocoding/directions
-func (c *Client) LookupDirections(ctx context.Context, request *RequestDirections) (LookupDirectionsRes, error) {
-	res, err := c.sendLookupDirections(ctx, request)
+// POST /geocoding/autocomplete
+func (c *Client) AutocompleteLocation(ctx context.Context, request *RequestAutocomplete) (AutocompleteLocationRes, error) {
+	res, err := c.sendAutocompleteLocation(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendLookupDirections(ctx context.Context, request *RequestDirections) (res LookupDirectionsRes, err error) {
+func (c *Client) sendAutocompleteLocation(ctx context.Context, request *RequestAutocomplete) (res AutocompleteLocationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/geocoding/directions"
+	pathParts[0] = "/geocoding/autocomplete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeLookupDirectionsRequest(request, r); err != nil {
+	if err := encodeAutocompleteLocationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -121,9 +251,16 @@ func (c *Client) sendLookupDirections(ctx context.Context, request *RequestDirec
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
-	result, err := decodeLookupDirectionsResponse(resp)
+	result, err := decodeAutocompleteLocationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -131,52 +268,216 @@ func (c *Client) sendLookupDirections(ctx context.Context, request *RequestDirec
 	return result, nil
 }
 
-// LookupLocation invokes lookupLocation operation.
+// CreateWatch invokes createWatch operation.
 //
-// Lookup location.
+// Flights only change with their scheduled times or aircraft, as their schedules carry no gates or
+// realtime delays. Changes are posted as signed watch.changed events to the webhook URL until the
+// journey arrived, followed by a watch.expired event. Webhook URLs must use https and point to a
+// public address, and are only accepted if a webhook secret is configured, push subscriptions only if
+// a VAPID key is.
 //
-// POST /geocoding/location
-func (c *Client) LookupLocation(ctx context.Context, params LookupLocationParams) (LookupLocationRes, error) {
-	res, err := c.sendLookupLocation(ctx, params)
+// POST /watches
+func (c *Client) CreateWatch(ctx context.Context, request *RequestWatch) (CreateWatchRes, error) {
+	res, err := c.sendCreateWatch(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendLookupLocation(ctx context.Context, params LookupLocationParams) (res LookupLocationRes, err error) {
+func (c *Client) sendCreateWatch(ctx context.Context, request *RequestWatch) (res CreateWatchRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/geocoding/location"
+	pathParts[0] = "/watches"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateWatchRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeCreateWatchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteWatch invokes deleteWatch operation.
+//
+// Delete watch.
+//
+// DELETE /watches/{id}
+func (c *Client) DeleteWatch(ctx context.Context, params DeleteWatchParams) (DeleteWatchRes, error) {
+	res, err := c.sendDeleteWatch(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteWatch(ctx context.Context, params DeleteWatchParams) (res DeleteWatchRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/watches/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeDeleteWatchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListWatches invokes listWatches operation.
+//
+// List watches.
+//
+// GET /watches
+func (c *Client) ListWatches(ctx context.Context, params ListWatchesParams) (ListWatchesRes, error) {
+	res, err := c.sendListWatches(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListWatches(ctx context.Context, params ListWatchesParams) (res ListWatchesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/watches"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "query" parameter.
+		// Encode "subscriberKey" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "query",
+			Name:    "subscriberKey",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Query))
+			return e.EncodeValue(conv.StringToString(params.SubscriberKey))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	u.RawQuery = q.Values().Encode()
 
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeListWatchesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LookupDirections invokes lookupDirections operation.
+//
+// Lookup directions.
+//
+// POST /geocoding/directions
+func (c *Client) LookupDirections(ctx context.Context, request *RequestDirections) (LookupDirectionsRes, error) {
+	res, err := c.sendLookupDirections(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLookupDirections(ctx context.Context, request *RequestDirections) (res LookupDirectionsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/directions"
+	uri.AddPathParts(u, pathParts[:]...)
+
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeLookupDirectionsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
-	result, err := decodeLookupLocationResponse(resp)
+	result, err := decodeLookupDirectionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -184,21 +485,67 @@ func (c *Client) sendLookupLocation(ctx context.Context, params LookupLocationPa
 	return result, nil
 }
 
-// LookupTrainStation invokes lookupTrainStation operation.
+// LookupIsochrones invokes lookupIsochrones operation.
 //
-// Lookup train station.
+// Lookup isochrones.
 //
-// POST /geocoding/station
-func (c *Client) LookupTrainStation(ctx context.Context, params LookupTrainStationParams) (LookupTrainStationRes, error) {
-	res, err := c.sendLookupTrainStation(ctx, params)
+// POST /geocoding/isochrones
+func (c *Client) LookupIsochrones(ctx context.Context, request *RequestIsochrones) (LookupIsochronesRes, error) {
+	res, err := c.sendLookupIsochrones(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendLookupTrainStation(ctx context.Context, params LookupTrainStationParams) (res LookupTrainStationRes, err error) {
+func (c *Client) sendLookupIsochrones(ctx context.Context, request *RequestIsochrones) (res LookupIsochronesRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/geocoding/station"
+	pathParts[0] = "/geocoding/isochrones"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLookupIsochronesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeLookupIsochronesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LookupLocation invokes lookupLocation operation.
+//
+// Lookup location.
+//
+// POST /geocoding/location
+func (c *Client) LookupLocation(ctx context.Context, params LookupLocationParams) (LookupLocationRes, error) {
+	res, err := c.sendLookupLocation(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendLookupLocation(ctx context.Context, params LookupLocationParams) (res LookupLocationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/location"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -227,9 +574,16 @@ func (c *Client) sendLookupTrainStation(ctx context.Context, params LookupTrainS
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
-	result, err := decodeLookupTrainStationResponse(resp)
+	result, err := decodeLookupLocationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -237,28 +591,28 @@ func (c *Client) sendLookupTrainStation(ctx context.Context, params LookupTrainS
 	return result, nil
 }
 
-// PostFlight invokes postFlight operation.
+// LookupMatrix invokes lookupMatrix operation.
 //
-// Find flight.
+// Lookup travel time matrix.
 //
-// POST /flights
-func (c *Client) PostFlight(ctx context.Context, request *RequestFlight) (PostFlightRes, error) {
-	res, err := c.sendPostFlight(ctx, request)
+// POST /geocoding/matrix
+func (c *Client) LookupMatrix(ctx context.Context, request *RequestMatrix) (LookupMatrixRes, error) {
+	res, err := c.sendLookupMatrix(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight) (res PostFlightRes, err error) {
+func (c *Client) sendLookupMatrix(ctx context.Context, request *RequestMatrix) (res LookupMatrixRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/flights"
+	pathParts[0] = "/geocoding/matrix"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostFlightRequest(request, r); err != nil {
+	if err := encodeLookupMatrixRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -266,9 +620,16 @@ func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight) (re
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
-	result, err := decodePostFlightResponse(resp)
+	result, err := decodeLookupMatrixResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -276,38 +637,974 @@ func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight) (re
 	return result, nil
 }
 
-// PostTrainJourney invokes postTrainJourney operation.
+// LookupTrainStation invokes lookupTrainStation operation.
 //
-// Find train journey.
+// Lookup train station.
 //
-// POST /trains
-func (c *Client) PostTrainJourney(ctx context.Context, request *RequestTrain) (PostTrainJourneyRes, error) {
-	res, err := c.sendPostTrainJourney(ctx, request)
+// POST /geocoding/station
+func (c *Client) LookupTrainStation(ctx context.Context, params LookupTrainStationParams) (LookupTrainStationRes, error) {
+	res, err := c.sendLookupTrainStation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrain) (res PostTrainJourneyRes, err error) {
+func (c *Client) sendLookupTrainStation(ctx context.Context, params LookupTrainStationParams) (res LookupTrainStationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/trains"
+	pathParts[0] = "/geocoding/station"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Query))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostTrainJourneyRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
-	defer resp.Body.Close()
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
 
-	result, err := decodePostTrainJourneyResponse(resp)
+	result, err := decodeLookupTrainStationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LookupTransitAlerts invokes lookupTransitAlerts operation.
+//
+// Lookup active service alerts.
+//
+// POST /transit/alerts
+func (c *Client) LookupTransitAlerts(ctx context.Context, params LookupTransitAlertsParams) (LookupTransitAlertsRes, error) {
+	res, err := c.sendLookupTransitAlerts(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendLookupTransitAlerts(ctx context.Context, params LookupTransitAlertsParams) (res LookupTransitAlertsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transit/alerts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "feed" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "feed",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Feed.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "routeId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "routeId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.RouteId.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "stopId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "stopId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.StopId.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeLookupTransitAlertsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LookupTransitStops invokes lookupTransitStops operation.
+//
+// Lookup transit stops.
+//
+// POST /transit/stops
+func (c *Client) LookupTransitStops(ctx context.Context, params LookupTransitStopsParams) (LookupTransitStopsRes, error) {
+	res, err := c.sendLookupTransitStops(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendLookupTransitStops(ctx context.Context, params LookupTransitStopsParams) (res LookupTransitStopsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transit/stops"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Query))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeLookupTransitStopsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PlanRoadTrip invokes planRoadTrip operation.
+//
+// Plan road trip.
+//
+// POST /geocoding/roadtrip
+func (c *Client) PlanRoadTrip(ctx context.Context, request *RequestRoadTrip) (PlanRoadTripRes, error) {
+	res, err := c.sendPlanRoadTrip(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPlanRoadTrip(ctx context.Context, request *RequestRoadTrip) (res PlanRoadTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/roadtrip"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePlanRoadTripRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePlanRoadTripResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostBatch invokes postBatch operation.
+//
+// Items are validated and processed independently. Each result has the item's ID and either its result
+// or a problem details error.
+//
+// POST /batch
+func (c *Client) PostBatch(ctx context.Context, request *RequestBatch) (PostBatchRes, error) {
+	res, err := c.sendPostBatch(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostBatch(ctx context.Context, request *RequestBatch) (res PostBatchRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/batch"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostBatchRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostBatchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostFlight invokes postFlight operation.
+//
+// Find flight.
+//
+// POST /flights
+func (c *Client) PostFlight(ctx context.Context, request *RequestFlight) (PostFlightRes, error) {
+	res, err := c.sendPostFlight(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight) (res PostFlightRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/flights"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostFlightRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostFlightResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTrainJourney invokes postTrainJourney operation.
+//
+// Find train journey.
+//
+// POST /trains
+func (c *Client) PostTrainJourney(ctx context.Context, request *RequestTrain) (PostTrainJourneyRes, error) {
+	res, err := c.sendPostTrainJourney(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrain) (res PostTrainJourneyRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trains"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTrainJourneyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostTrainJourneyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTrainTrip invokes postTrainTrip operation.
+//
+// Find train trip by train number.
+//
+// POST /trains/trip
+func (c *Client) PostTrainTrip(ctx context.Context, request *RequestTrainTrip) (PostTrainTripRes, error) {
+	res, err := c.sendPostTrainTrip(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostTrainTrip(ctx context.Context, request *RequestTrainTrip) (res PostTrainTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trains/trip"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTrainTripRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostTrainTripResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTrainTripSection invokes postTrainTripSection operation.
+//
+// Find train journey from a section of a train trip.
+//
+// POST /trains/trip/section
+func (c *Client) PostTrainTripSection(ctx context.Context, request *RequestTrainTripSection) (PostTrainTripSectionRes, error) {
+	res, err := c.sendPostTrainTripSection(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostTrainTripSection(ctx context.Context, request *RequestTrainTripSection) (res PostTrainTripSectionRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trains/trip/section"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTrainTripSectionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostTrainTripSectionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTransit invokes postTransit operation.
+//
+// Find transit.
+//
+// POST /transit
+func (c *Client) PostTransit(ctx context.Context, request *RequestTransit) (PostTransitRes, error) {
+	res, err := c.sendPostTransit(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostTransit(ctx context.Context, request *RequestTransit) (res PostTransitRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transit"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTransitRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostTransitResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTransitTrips invokes postTransitTrips operation.
+//
+// Find transit trips by route and date.
+//
+// POST /transit/trips
+func (c *Client) PostTransitTrips(ctx context.Context, request *RequestTransitTrips) (PostTransitTripsRes, error) {
+	res, err := c.sendPostTransitTrips(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostTransitTrips(ctx context.Context, request *RequestTransitTrips) (res PostTransitTripsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/transit/trips"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTransitTripsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodePostTransitTripsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RetrieveVapidKey invokes retrieveVapidKey operation.
+//
+// The application server key to subscribe to push messages with.
+//
+// GET /push/key
+func (c *Client) RetrieveVapidKey(ctx context.Context) (RetrieveVapidKeyRes, error) {
+	res, err := c.sendRetrieveVapidKey(ctx)
+	return res, err
+}
+
+func (c *Client) sendRetrieveVapidKey(ctx context.Context) (res RetrieveVapidKeyRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/push/key"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeRetrieveVapidKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RetrieveWatch invokes retrieveWatch operation.
+//
+// Retrieve watch.
+//
+// GET /watches/{id}
+func (c *Client) RetrieveWatch(ctx context.Context, params RetrieveWatchParams) (RetrieveWatchRes, error) {
+	res, err := c.sendRetrieveWatch(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRetrieveWatch(ctx context.Context, params RetrieveWatchParams) (res RetrieveWatchRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/watches/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeRetrieveWatchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReverseGeocode invokes reverseGeocode operation.
+//
+// Reverse geocode location.
+//
+// GET /geocoding/reverse
+func (c *Client) ReverseGeocode(ctx context.Context, params ReverseGeocodeParams) (ReverseGeocodeRes, error) {
+	res, err := c.sendReverseGeocode(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendReverseGeocode(ctx context.Context, params ReverseGeocodeParams) (res ReverseGeocodeRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/reverse"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "lat" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "lat",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Float64ToString(params.Lat))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "lon" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "lon",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Float64ToString(params.Lon))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeReverseGeocodeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchPois invokes searchPois operation.
+//
+// Search points of interest.
+//
+// POST /geocoding/pois
+func (c *Client) SearchPois(ctx context.Context, request *RequestPois) (SearchPoisRes, error) {
+	res, err := c.sendSearchPois(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSearchPois(ctx context.Context, request *RequestPois) (res SearchPoisRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/pois"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSearchPoisRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeSearchPoisResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SendPush invokes sendPush operation.
+//
+// Sends the notification as encrypted Web Push message. Subscriptions which expired are reported with
+// status 410 and should be removed.
+//
+// POST /push
+func (c *Client) SendPush(ctx context.Context, request *RequestPush) (SendPushRes, error) {
+	res, err := c.sendSendPush(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSendPush(ctx context.Context, request *RequestPush) (res SendPushRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/push"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSendPushRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeSendPushResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// StreamLive invokes streamLive operation.
+//
+// Server-Sent Events of the given flights and trains. Each subject is sent as a snapshot event first,
+// then as changed events when delays, platforms, aircraft or cancellations change. Flights are
+// followed by their schedules, which carry no gates or realtime delays, so flights only change with
+// their scheduled times or aircraft. Streams are resumed with the Last-Event-ID header. Comments are
+// sent as heartbeats.
+//
+// GET /live
+func (c *Client) StreamLive(ctx context.Context, params StreamLiveParams) (StreamLiveRes, error) {
+	res, err := c.sendStreamLive(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendStreamLive(ctx context.Context, params StreamLiveParams) (res StreamLiveRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/live"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "flight" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "flight",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Flight != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Flight {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "train" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "train",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Train != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Train {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Last-Event-ID",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.LastEventID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer func() {
+		// Drain the body to EOF before closing, so the underlying
+		// connection can be reused by the Transport regardless of the
+		// response status code. See https://github.com/ogen-go/ogen/issues/1670.
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	result, err := decodeStreamLiveResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
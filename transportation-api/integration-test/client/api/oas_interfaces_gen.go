@@ -1,18 +1,58 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AutocompleteLocationRes interface {
+	autocompleteLocationRes()
+}
+
+type CreateWatchRes interface {
+	createWatchRes()
+}
+
+type DeleteWatchRes interface {
+	deleteWatchRes()
+}
+
+type ListWatchesRes interface {
+	listWatchesRes()
+}
+
 type LookupDirectionsRes interface {
 	lookupDirectionsRes()
 }
 
+type LookupIsochronesRes interface {
+	lookupIsochronesRes()
+}
+
 type LookupLocationRes interface {
 	lookupLocationRes()
 }
 
+type LookupMatrixRes interface {
+	lookupMatrixRes()
+}
+
 type LookupTrainStationRes interface {
 	lookupTrainStationRes()
 }
 
+type LookupTransitAlertsRes interface {
+	lookupTransitAlertsRes()
+}
+
+type LookupTransitStopsRes interface {
+	lookupTransitStopsRes()
+}
+
+type PlanRoadTripRes interface {
+	planRoadTripRes()
+}
+
+type PostBatchRes interface {
+	postBatchRes()
+}
+
 type PostFlightRes interface {
 	postFlightRes()
 }
@@ -20,3 +60,43 @@ type PostFlightRes interface {
 type PostTrainJourneyRes interface {
 	postTrainJourneyRes()
 }
+
+type PostTrainTripRes interface {
+	postTrainTripRes()
+}
+
+type PostTrainTripSectionRes interface {
+	postTrainTripSectionRes()
+}
+
+type PostTransitRes interface {
+	postTransitRes()
+}
+
+type PostTransitTripsRes interface {
+	postTransitTripsRes()
+}
+
+type RetrieveVapidKeyRes interface {
+	retrieveVapidKeyRes()
+}
+
+type RetrieveWatchRes interface {
+	retrieveWatchRes()
+}
+
+type ReverseGeocodeRes interface {
+	reverseGeocodeRes()
+}
+
+type SearchPoisRes interface {
+	searchPoisRes()
+}
+
+type SendPushRes interface {
+	sendPushRes()
+}
+
+type StreamLiveRes interface {
+	streamLiveRes()
+}
@@ -6,9 +6,29 @@ package api
 type OperationName = string
 
 const (
-	LookupDirectionsOperation   OperationName = "LookupDirections"
-	LookupLocationOperation     OperationName = "LookupLocation"
-	LookupTrainStationOperation OperationName = "LookupTrainStation"
-	PostFlightOperation         OperationName = "PostFlight"
-	PostTrainJourneyOperation   OperationName = "PostTrainJourney"
+	AutocompleteLocationOperation OperationName = "AutocompleteLocation"
+	CreateWatchOperation          OperationName = "CreateWatch"
+	DeleteWatchOperation          OperationName = "DeleteWatch"
+	ListWatchesOperation          OperationName = "ListWatches"
+	LookupDirectionsOperation     OperationName = "LookupDirections"
+	LookupIsochronesOperation     OperationName = "LookupIsochrones"
+	LookupLocationOperation       OperationName = "LookupLocation"
+	LookupMatrixOperation         OperationName = "LookupMatrix"
+	LookupTrainStationOperation   OperationName = "LookupTrainStation"
+	LookupTransitAlertsOperation  OperationName = "LookupTransitAlerts"
+	LookupTransitStopsOperation   OperationName = "LookupTransitStops"
+	PlanRoadTripOperation         OperationName = "PlanRoadTrip"
+	PostBatchOperation            OperationName = "PostBatch"
+	PostFlightOperation           OperationName = "PostFlight"
+	PostTrainJourneyOperation     OperationName = "PostTrainJourney"
+	PostTrainTripOperation        OperationName = "PostTrainTrip"
+	PostTrainTripSectionOperation OperationName = "PostTrainTripSection"
+	PostTransitOperation          OperationName = "PostTransit"
+	PostTransitTripsOperation     OperationName = "PostTransitTrips"
+	RetrieveVapidKeyOperation     OperationName = "RetrieveVapidKey"
+	RetrieveWatchOperation        OperationName = "RetrieveWatch"
+	ReverseGeocodeOperation       OperationName = "ReverseGeocode"
+	SearchPoisOperation           OperationName = "SearchPois"
+	SendPushOperation             OperationName = "SendPush"
+	StreamLiveOperation           OperationName = "StreamLive"
 )
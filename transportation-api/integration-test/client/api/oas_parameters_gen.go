@@ -2,6 +2,18 @@
 
 package api
 
+// DeleteWatchParams is parameters of deleteWatch operation.
+type DeleteWatchParams struct {
+	// Watch ID.
+	ID string
+}
+
+// ListWatchesParams is parameters of listWatches operation.
+type ListWatchesParams struct {
+	// Subscriber key.
+	SubscriberKey string
+}
+
 // LookupLocationParams is parameters of lookupLocation operation.
 type LookupLocationParams struct {
 	// Location query.
@@ -13,3 +25,44 @@ type LookupTrainStationParams struct {
 	// Station query.
 	Query string
 }
+
+// LookupTransitAlertsParams is parameters of lookupTransitAlerts operation.
+type LookupTransitAlertsParams struct {
+	// GTFS feed.
+	Feed OptString `json:",omitempty,omitzero"`
+	// Route id.
+	RouteId OptString `json:",omitempty,omitzero"`
+	// Stop id.
+	StopId OptString `json:",omitempty,omitzero"`
+}
+
+// LookupTransitStopsParams is parameters of lookupTransitStops operation.
+type LookupTransitStopsParams struct {
+	// Stop query.
+	Query string
+}
+
+// RetrieveWatchParams is parameters of retrieveWatch operation.
+type RetrieveWatchParams struct {
+	// Watch ID.
+	ID string
+}
+
+// ReverseGeocodeParams is parameters of reverseGeocode operation.
+type ReverseGeocodeParams struct {
+	// Latitude.
+	Lat float64
+	// Longitude.
+	Lon float64
+}
+
+// StreamLiveParams is parameters of streamLive operation.
+type StreamLiveParams struct {
+	// Flights as flightNumber:date[:originAirport], e.g. EK412:2026-01-30.
+	Flight []string `json:",omitempty"`
+	// Train journeys as fromStationId:toStationId:departureDate:trainNumber[,trainNumber], e.g.
+	// 8011113:8000261:2025-09-20:ICE707.
+	Train []string `json:",omitempty"`
+	// ID of the last received event.
+	LastEventID OptString `json:",omitempty,omitzero"`
+}
@@ -0,0 +1,57 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import "github.com/ogen-go/ogen/validate"
+
+// Equal compares two RequestBatchItem instances for equality.
+// The depth parameter prevents infinite recursion on nested objects.
+func (a RequestBatchItem) Equal(b RequestBatchItem, depth int) bool {
+	if depth > 10 {
+		panic(&validate.DepthLimitError{
+			MaxDepth: 10,
+			TypeName: "RequestBatchItem",
+		})
+	}
+
+	// Compare optional field: Flight
+	if a.Flight.Set != b.Flight.Set {
+		return false
+	}
+	if a.Flight.Set {
+		if !a.Flight.Value.Equal(b.Flight.Value, depth+1) {
+			return false
+		}
+	}
+	if a.ID != b.ID {
+		return false
+	}
+	// Compare optional field: Train
+	if a.Train.Set != b.Train.Set {
+		return false
+	}
+	if a.Train.Set {
+		if !a.Train.Value.Equal(b.Train.Value, depth+1) {
+			return false
+		}
+	}
+	// Compare optional field: TrainTrip
+	if a.TrainTrip.Set != b.TrainTrip.Set {
+		return false
+	}
+	if a.TrainTrip.Set {
+		if !a.TrainTrip.Value.Equal(b.TrainTrip.Value, depth+1) {
+			return false
+		}
+	}
+	// Compare optional field: TrainTripSection
+	if a.TrainTripSection.Set != b.TrainTripSection.Set {
+		return false
+	}
+	if a.TrainTripSection.Set {
+		if !a.TrainTripSection.Value.Equal(b.TrainTripSection.Value, depth+1) {
+			return false
+		}
+	}
+	return true
+}
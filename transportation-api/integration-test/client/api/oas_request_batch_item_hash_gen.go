@@ -0,0 +1,51 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash computes a hash value for RequestBatchItem using FNV-1a.
+// Equal objects must produce equal hashes.
+func (a RequestBatchItem) Hash() uint64 {
+	h := fnv.New64a()
+
+	// Hash optional field: Flight
+	if a.Flight.Set {
+		h.Write([]byte{1})
+		nestedHashFlight := a.Flight.Value.Hash()
+		binary.Write(h, binary.LittleEndian, nestedHashFlight)
+	} else {
+		h.Write([]byte{0})
+	}
+	// Hash primitive field: ID
+	h.Write([]byte(fmt.Sprintf("%v", a.ID)))
+	// Hash optional field: Train
+	if a.Train.Set {
+		h.Write([]byte{1})
+		nestedHashTrain := a.Train.Value.Hash()
+		binary.Write(h, binary.LittleEndian, nestedHashTrain)
+	} else {
+		h.Write([]byte{0})
+	}
+	// Hash optional field: TrainTrip
+	if a.TrainTrip.Set {
+		h.Write([]byte{1})
+		nestedHashTrainTrip := a.TrainTrip.Value.Hash()
+		binary.Write(h, binary.LittleEndian, nestedHashTrainTrip)
+	} else {
+		h.Write([]byte{0})
+	}
+	// Hash optional field: TrainTripSection
+	if a.TrainTripSection.Set {
+		h.Write([]byte{1})
+		nestedHashTrainTripSection := a.TrainTripSection.Value.Hash()
+		binary.Write(h, binary.LittleEndian, nestedHashTrainTripSection)
+	} else {
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
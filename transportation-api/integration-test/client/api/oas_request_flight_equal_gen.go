@@ -0,0 +1,27 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import "github.com/ogen-go/ogen/validate"
+
+// Equal compares two RequestFlight instances for equality.
+// The depth parameter prevents infinite recursion on nested objects.
+func (a RequestFlight) Equal(b RequestFlight, depth int) bool {
+	if depth > 10 {
+		panic(&validate.DepthLimitError{
+			MaxDepth: 10,
+			TypeName: "RequestFlight",
+		})
+	}
+
+	// Compare array field: Legs
+	if len(a.Legs) != len(b.Legs) {
+		return false
+	}
+	for i := range a.Legs {
+		if !a.Legs[i].Equal(b.Legs[i], depth+1) {
+			return false
+		}
+	}
+	return true
+}
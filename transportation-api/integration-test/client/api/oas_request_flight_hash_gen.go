@@ -0,0 +1,20 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash computes a hash value for RequestFlight using FNV-1a.
+// Equal objects must produce equal hashes.
+func (a RequestFlight) Hash() uint64 {
+	h := fnv.New64a()
+
+	// Hash array field: Legs
+	for _, item := range a.Legs {
+		h.Write([]byte(fmt.Sprintf("%v", item)))
+	}
+	return h.Sum64()
+}
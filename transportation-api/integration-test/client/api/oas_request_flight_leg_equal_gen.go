@@ -0,0 +1,33 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import "github.com/ogen-go/ogen/validate"
+
+// Equal compares two RequestFlightLeg instances for equality.
+// The depth parameter prevents infinite recursion on nested objects.
+func (a RequestFlightLeg) Equal(b RequestFlightLeg, depth int) bool {
+	if depth > 10 {
+		panic(&validate.DepthLimitError{
+			MaxDepth: 10,
+			TypeName: "RequestFlightLeg",
+		})
+	}
+
+	if a.Date != b.Date {
+		return false
+	}
+	if a.FlightNumber != b.FlightNumber {
+		return false
+	}
+	// Compare nullable field: OriginAirport
+	if a.OriginAirport.Null != b.OriginAirport.Null {
+		return false
+	}
+	if !a.OriginAirport.Null {
+		if a.OriginAirport.Value != b.OriginAirport.Value {
+			return false
+		}
+	}
+	return true
+}
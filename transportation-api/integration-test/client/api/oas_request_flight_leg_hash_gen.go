@@ -0,0 +1,27 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash computes a hash value for RequestFlightLeg using FNV-1a.
+// Equal objects must produce equal hashes.
+func (a RequestFlightLeg) Hash() uint64 {
+	h := fnv.New64a()
+
+	// Hash primitive field: Date
+	h.Write([]byte(fmt.Sprintf("%v", a.Date)))
+	// Hash primitive field: FlightNumber
+	h.Write([]byte(fmt.Sprintf("%v", a.FlightNumber)))
+	// Hash nullable field: OriginAirport
+	if a.OriginAirport.Null {
+		h.Write([]byte{0})
+	} else {
+		h.Write([]byte{1})
+		h.Write([]byte(fmt.Sprintf("%v", a.OriginAirport.Value)))
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,54 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import "github.com/ogen-go/ogen/validate"
+
+// Equal compares two RequestTrain instances for equality.
+// The depth parameter prevents infinite recursion on nested objects.
+func (a RequestTrain) Equal(b RequestTrain, depth int) bool {
+	if depth > 10 {
+		panic(&validate.DepthLimitError{
+			MaxDepth: 10,
+			TypeName: "RequestTrain",
+		})
+	}
+
+	if a.DepartureDate != b.DepartureDate {
+		return false
+	}
+	if a.FromStationId != b.FromStationId {
+		return false
+	}
+	// Compare nullable field: Provider
+	if a.Provider.Null != b.Provider.Null {
+		return false
+	}
+	if !a.Provider.Null {
+		if a.Provider.Value != b.Provider.Value {
+			return false
+		}
+	}
+	if a.ToStationId != b.ToStationId {
+		return false
+	}
+	// Compare array field: TrainNumbers
+	if len(a.TrainNumbers) != len(b.TrainNumbers) {
+		return false
+	}
+	for i := range a.TrainNumbers {
+		if a.TrainNumbers[i] != b.TrainNumbers[i] {
+			return false
+		}
+	}
+	// Compare nullable field: ViaStationId
+	if a.ViaStationId.Null != b.ViaStationId.Null {
+		return false
+	}
+	if !a.ViaStationId.Null {
+		if a.ViaStationId.Value != b.ViaStationId.Value {
+			return false
+		}
+	}
+	return true
+}
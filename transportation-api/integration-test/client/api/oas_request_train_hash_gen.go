@@ -0,0 +1,40 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash computes a hash value for RequestTrain using FNV-1a.
+// Equal objects must produce equal hashes.
+func (a RequestTrain) Hash() uint64 {
+	h := fnv.New64a()
+
+	// Hash primitive field: DepartureDate
+	h.Write([]byte(fmt.Sprintf("%v", a.DepartureDate)))
+	// Hash primitive field: FromStationId
+	h.Write([]byte(fmt.Sprintf("%v", a.FromStationId)))
+	// Hash nullable field: Provider
+	if a.Provider.Null {
+		h.Write([]byte{0})
+	} else {
+		h.Write([]byte{1})
+		h.Write([]byte(fmt.Sprintf("%v", a.Provider.Value)))
+	}
+	// Hash primitive field: ToStationId
+	h.Write([]byte(fmt.Sprintf("%v", a.ToStationId)))
+	// Hash array field: TrainNumbers
+	for _, item := range a.TrainNumbers {
+		h.Write([]byte(fmt.Sprintf("%v", item)))
+	}
+	// Hash nullable field: ViaStationId
+	if a.ViaStationId.Null {
+		h.Write([]byte{0})
+	} else {
+		h.Write([]byte{1})
+		h.Write([]byte(fmt.Sprintf("%v", a.ViaStationId.Value)))
+	}
+	return h.Sum64()
+}
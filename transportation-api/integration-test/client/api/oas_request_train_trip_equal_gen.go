@@ -0,0 +1,24 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import "github.com/ogen-go/ogen/validate"
+
+// Equal compares two RequestTrainTrip instances for equality.
+// The depth parameter prevents infinite recursion on nested objects.
+func (a RequestTrainTrip) Equal(b RequestTrainTrip, depth int) bool {
+	if depth > 10 {
+		panic(&validate.DepthLimitError{
+			MaxDepth: 10,
+			TypeName: "RequestTrainTrip",
+		})
+	}
+
+	if a.DepartureDate != b.DepartureDate {
+		return false
+	}
+	if a.TrainNumber != b.TrainNumber {
+		return false
+	}
+	return true
+}
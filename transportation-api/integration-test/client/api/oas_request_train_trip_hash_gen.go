@@ -0,0 +1,20 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash computes a hash value for RequestTrainTrip using FNV-1a.
+// Equal objects must produce equal hashes.
+func (a RequestTrainTrip) Hash() uint64 {
+	h := fnv.New64a()
+
+	// Hash primitive field: DepartureDate
+	h.Write([]byte(fmt.Sprintf("%v", a.DepartureDate)))
+	// Hash primitive field: TrainNumber
+	h.Write([]byte(fmt.Sprintf("%v", a.TrainNumber)))
+	return h.Sum64()
+}
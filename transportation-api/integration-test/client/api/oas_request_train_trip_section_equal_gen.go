@@ -0,0 +1,30 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import "github.com/ogen-go/ogen/validate"
+
+// Equal compares two RequestTrainTripSection instances for equality.
+// The depth parameter prevents infinite recursion on nested objects.
+func (a RequestTrainTripSection) Equal(b RequestTrainTripSection, depth int) bool {
+	if depth > 10 {
+		panic(&validate.DepthLimitError{
+			MaxDepth: 10,
+			TypeName: "RequestTrainTripSection",
+		})
+	}
+
+	if a.DepartureDate != b.DepartureDate {
+		return false
+	}
+	if a.FromStationId != b.FromStationId {
+		return false
+	}
+	if a.ToStationId != b.ToStationId {
+		return false
+	}
+	if a.TrainNumber != b.TrainNumber {
+		return false
+	}
+	return true
+}
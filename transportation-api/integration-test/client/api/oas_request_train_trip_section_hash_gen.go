@@ -0,0 +1,24 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Hash computes a hash value for RequestTrainTripSection using FNV-1a.
+// Equal objects must produce equal hashes.
+func (a RequestTrainTripSection) Hash() uint64 {
+	h := fnv.New64a()
+
+	// Hash primitive field: DepartureDate
+	h.Write([]byte(fmt.Sprintf("%v", a.DepartureDate)))
+	// Hash primitive field: FromStationId
+	h.Write([]byte(fmt.Sprintf("%v", a.FromStationId)))
+	// Hash primitive field: ToStationId
+	h.Write([]byte(fmt.Sprintf("%v", a.ToStationId)))
+	// Hash primitive field: TrainNumber
+	h.Write([]byte(fmt.Sprintf("%v", a.TrainNumber)))
+	return h.Sum64()
+}
@@ -2,6 +2,53 @@
 
 package api
 
+import (
+	"io"
+
+	"github.com/go-faster/errors"
+)
+
+type AutocompleteLocationBadRequest ResponseError
+
+func (*AutocompleteLocationBadRequest) autocompleteLocationRes() {}
+
+type AutocompleteLocationInternalServerError ResponseError
+
+func (*AutocompleteLocationInternalServerError) autocompleteLocationRes() {}
+
+type AutocompleteLocationOKApplicationJSON []EntityGeocodePlace
+
+func (*AutocompleteLocationOKApplicationJSON) autocompleteLocationRes() {}
+
+type CreateWatchBadRequest ResponseError
+
+func (*CreateWatchBadRequest) createWatchRes() {}
+
+type CreateWatchInternalServerError ResponseError
+
+func (*CreateWatchInternalServerError) createWatchRes() {}
+
+type CreateWatchNotFound ResponseError
+
+func (*CreateWatchNotFound) createWatchRes() {}
+
+type CreateWatchNotImplemented ResponseError
+
+func (*CreateWatchNotImplemented) createWatchRes() {}
+
+type DeleteWatchInternalServerError ResponseError
+
+func (*DeleteWatchInternalServerError) deleteWatchRes() {}
+
+// DeleteWatchNoContent is response for DeleteWatch operation.
+type DeleteWatchNoContent struct{}
+
+func (*DeleteWatchNoContent) deleteWatchRes() {}
+
+type DeleteWatchNotFound ResponseError
+
+func (*DeleteWatchNotFound) deleteWatchRes() {}
+
 // Ref: #/components/schemas/entity.Airport
 type EntityAirport struct {
 	Iata         string         `json:"iata"`
@@ -50,6 +97,32 @@ func (s *EntityAirport) SetName(val string) {
 	s.Name = val
 }
 
+// Ref: #/components/schemas/entity.AlertPeriod
+type EntityAlertPeriod struct {
+	End   NilString `json:"end"`
+	Start NilString `json:"start"`
+}
+
+// GetEnd returns the value of End.
+func (s *EntityAlertPeriod) GetEnd() NilString {
+	return s.End
+}
+
+// GetStart returns the value of Start.
+func (s *EntityAlertPeriod) GetStart() NilString {
+	return s.Start
+}
+
+// SetEnd sets the value of End.
+func (s *EntityAlertPeriod) SetEnd(val NilString) {
+	s.End = val
+}
+
+// SetStart sets the value of Start.
+func (s *EntityAlertPeriod) SetStart(val NilString) {
+	s.Start = val
+}
+
 // Ref: #/components/schemas/entity.AmbiguousFlightChoice
 type EntityAmbiguousFlightChoice struct {
 	DepartureDateTime string `json:"departureDateTime"`
@@ -87,6 +160,62 @@ func (s *EntityAmbiguousFlightChoice) SetOriginIata(val string) {
 	s.OriginIata = val
 }
 
+// Ref: #/components/schemas/entity.Directions
+type EntityDirections struct {
+	GeoJson EntityDirectionsGeoJson `json:"geoJson"`
+	Routes  []EntityRoute           `json:"routes"`
+}
+
+// GetGeoJson returns the value of GeoJson.
+func (s *EntityDirections) GetGeoJson() EntityDirectionsGeoJson {
+	return s.GeoJson
+}
+
+// GetRoutes returns the value of Routes.
+func (s *EntityDirections) GetRoutes() []EntityRoute {
+	return s.Routes
+}
+
+// SetGeoJson sets the value of GeoJson.
+func (s *EntityDirections) SetGeoJson(val EntityDirectionsGeoJson) {
+	s.GeoJson = val
+}
+
+// SetRoutes sets the value of Routes.
+func (s *EntityDirections) SetRoutes(val []EntityRoute) {
+	s.Routes = val
+}
+
+func (*EntityDirections) lookupDirectionsRes() {}
+
+type EntityDirectionsGeoJson struct{}
+
+// Ref: #/components/schemas/entity.ElevationPoint
+type EntityElevationPoint struct {
+	DistanceInMeters  float64 `json:"distanceInMeters"`
+	ElevationInMeters float64 `json:"elevationInMeters"`
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityElevationPoint) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetElevationInMeters returns the value of ElevationInMeters.
+func (s *EntityElevationPoint) GetElevationInMeters() float64 {
+	return s.ElevationInMeters
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityElevationPoint) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetElevationInMeters sets the value of ElevationInMeters.
+func (s *EntityElevationPoint) SetElevationInMeters(val float64) {
+	s.ElevationInMeters = val
+}
+
 // Ref: #/components/schemas/entity.ErrAmbiguousFlightRequest
 type EntityErrAmbiguousFlightRequest map[string][]EntityAmbiguousFlightChoice
 
@@ -234,492 +363,4584 @@ func (s *EntityFlightLeg) SetOrigin(val EntityAirport) {
 	s.Origin = val
 }
 
-// Ref: #/components/schemas/entity.Location
-type EntityLocation struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
+// Ref: #/components/schemas/entity.GeocodeAddress
+type EntityGeocodeAddress struct {
+	Country     NilString `json:"country"`
+	CountryCode NilString `json:"countryCode"`
+	HouseNumber NilString `json:"houseNumber"`
+	Locality    NilString `json:"locality"`
+	PostalCode  NilString `json:"postalCode"`
+	Region      NilString `json:"region"`
+	Street      NilString `json:"street"`
 }
 
-// GetLatitude returns the value of Latitude.
-func (s *EntityLocation) GetLatitude() float64 {
-	return s.Latitude
+// GetCountry returns the value of Country.
+func (s *EntityGeocodeAddress) GetCountry() NilString {
+	return s.Country
 }
 
-// GetLongitude returns the value of Longitude.
-func (s *EntityLocation) GetLongitude() float64 {
-	return s.Longitude
+// GetCountryCode returns the value of CountryCode.
+func (s *EntityGeocodeAddress) GetCountryCode() NilString {
+	return s.CountryCode
 }
 
-// SetLatitude sets the value of Latitude.
-func (s *EntityLocation) SetLatitude(val float64) {
-	s.Latitude = val
+// GetHouseNumber returns the value of HouseNumber.
+func (s *EntityGeocodeAddress) GetHouseNumber() NilString {
+	return s.HouseNumber
 }
 
-// SetLongitude sets the value of Longitude.
-func (s *EntityLocation) SetLongitude(val float64) {
-	s.Longitude = val
+// GetLocality returns the value of Locality.
+func (s *EntityGeocodeAddress) GetLocality() NilString {
+	return s.Locality
 }
 
-func (*EntityLocation) lookupLocationRes() {}
-
-// Ref: #/components/schemas/entity.Train
-type EntityTrain struct {
-	GeoJson      EntityTrainGeoJson `json:"geoJson"`
-	Legs         []EntityTrainLeg   `json:"legs"`
-	RefreshToken string             `json:"refreshToken"`
+// GetPostalCode returns the value of PostalCode.
+func (s *EntityGeocodeAddress) GetPostalCode() NilString {
+	return s.PostalCode
 }
 
-// GetGeoJson returns the value of GeoJson.
-func (s *EntityTrain) GetGeoJson() EntityTrainGeoJson {
-	return s.GeoJson
+// GetRegion returns the value of Region.
+func (s *EntityGeocodeAddress) GetRegion() NilString {
+	return s.Region
 }
 
-// GetLegs returns the value of Legs.
-func (s *EntityTrain) GetLegs() []EntityTrainLeg {
-	return s.Legs
+// GetStreet returns the value of Street.
+func (s *EntityGeocodeAddress) GetStreet() NilString {
+	return s.Street
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *EntityTrain) GetRefreshToken() string {
-	return s.RefreshToken
+// SetCountry sets the value of Country.
+func (s *EntityGeocodeAddress) SetCountry(val NilString) {
+	s.Country = val
 }
 
-// SetGeoJson sets the value of GeoJson.
-func (s *EntityTrain) SetGeoJson(val EntityTrainGeoJson) {
-	s.GeoJson = val
+// SetCountryCode sets the value of CountryCode.
+func (s *EntityGeocodeAddress) SetCountryCode(val NilString) {
+	s.CountryCode = val
 }
 
-// SetLegs sets the value of Legs.
-func (s *EntityTrain) SetLegs(val []EntityTrainLeg) {
-	s.Legs = val
+// SetHouseNumber sets the value of HouseNumber.
+func (s *EntityGeocodeAddress) SetHouseNumber(val NilString) {
+	s.HouseNumber = val
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *EntityTrain) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// SetLocality sets the value of Locality.
+func (s *EntityGeocodeAddress) SetLocality(val NilString) {
+	s.Locality = val
 }
 
-func (*EntityTrain) postTrainJourneyRes() {}
+// SetPostalCode sets the value of PostalCode.
+func (s *EntityGeocodeAddress) SetPostalCode(val NilString) {
+	s.PostalCode = val
+}
 
-type EntityTrainGeoJson struct{}
+// SetRegion sets the value of Region.
+func (s *EntityGeocodeAddress) SetRegion(val NilString) {
+	s.Region = val
+}
 
-// Ref: #/components/schemas/entity.TrainLeg
-type EntityTrainLeg struct {
-	ArrivalDateTime   string             `json:"arrivalDateTime"`
-	DepartureDateTime string             `json:"departureDateTime"`
-	Destination       EntityTrainStation `json:"destination"`
-	DurationInMinutes int                `json:"durationInMinutes"`
-	LineName          string             `json:"lineName"`
-	OperatorName      string             `json:"operatorName"`
-	Origin            EntityTrainStation `json:"origin"`
+// SetStreet sets the value of Street.
+func (s *EntityGeocodeAddress) SetStreet(val NilString) {
+	s.Street = val
 }
 
-// GetArrivalDateTime returns the value of ArrivalDateTime.
-func (s *EntityTrainLeg) GetArrivalDateTime() string {
-	return s.ArrivalDateTime
+// Ref: #/components/schemas/entity.GeocodePlace
+type EntityGeocodePlace struct {
+	Address              EntityGeocodeAddress `json:"address"`
+	BoundingBox          []float64            `json:"boundingBox"`
+	DistanceInKilometers NilFloat64           `json:"distanceInKilometers"`
+	Label                string               `json:"label"`
+	Latitude             float64              `json:"latitude"`
+	Layer                string               `json:"layer"`
+	Longitude            float64              `json:"longitude"`
+	Name                 string               `json:"name"`
 }
 
-// GetDepartureDateTime returns the value of DepartureDateTime.
-func (s *EntityTrainLeg) GetDepartureDateTime() string {
-	return s.DepartureDateTime
+// GetAddress returns the value of Address.
+func (s *EntityGeocodePlace) GetAddress() EntityGeocodeAddress {
+	return s.Address
 }
 
-// GetDestination returns the value of Destination.
-func (s *EntityTrainLeg) GetDestination() EntityTrainStation {
-	return s.Destination
+// GetBoundingBox returns the value of BoundingBox.
+func (s *EntityGeocodePlace) GetBoundingBox() []float64 {
+	return s.BoundingBox
 }
 
-// GetDurationInMinutes returns the value of DurationInMinutes.
-func (s *EntityTrainLeg) GetDurationInMinutes() int {
-	return s.DurationInMinutes
+// GetDistanceInKilometers returns the value of DistanceInKilometers.
+func (s *EntityGeocodePlace) GetDistanceInKilometers() NilFloat64 {
+	return s.DistanceInKilometers
 }
 
-// GetLineName returns the value of LineName.
-func (s *EntityTrainLeg) GetLineName() string {
-	return s.LineName
+// GetLabel returns the value of Label.
+func (s *EntityGeocodePlace) GetLabel() string {
+	return s.Label
 }
 
-// GetOperatorName returns the value of OperatorName.
-func (s *EntityTrainLeg) GetOperatorName() string {
-	return s.OperatorName
+// GetLatitude returns the value of Latitude.
+func (s *EntityGeocodePlace) GetLatitude() float64 {
+	return s.Latitude
 }
 
-// GetOrigin returns the value of Origin.
-func (s *EntityTrainLeg) GetOrigin() EntityTrainStation {
-	return s.Origin
+// GetLayer returns the value of Layer.
+func (s *EntityGeocodePlace) GetLayer() string {
+	return s.Layer
 }
 
-// SetArrivalDateTime sets the value of ArrivalDateTime.
-func (s *EntityTrainLeg) SetArrivalDateTime(val string) {
-	s.ArrivalDateTime = val
+// GetLongitude returns the value of Longitude.
+func (s *EntityGeocodePlace) GetLongitude() float64 {
+	return s.Longitude
 }
 
-// SetDepartureDateTime sets the value of DepartureDateTime.
-func (s *EntityTrainLeg) SetDepartureDateTime(val string) {
-	s.DepartureDateTime = val
+// GetName returns the value of Name.
+func (s *EntityGeocodePlace) GetName() string {
+	return s.Name
 }
 
-// SetDestination sets the value of Destination.
-func (s *EntityTrainLeg) SetDestination(val EntityTrainStation) {
-	s.Destination = val
+// SetAddress sets the value of Address.
+func (s *EntityGeocodePlace) SetAddress(val EntityGeocodeAddress) {
+	s.Address = val
 }
 
-// SetDurationInMinutes sets the value of DurationInMinutes.
-func (s *EntityTrainLeg) SetDurationInMinutes(val int) {
-	s.DurationInMinutes = val
+// SetBoundingBox sets the value of BoundingBox.
+func (s *EntityGeocodePlace) SetBoundingBox(val []float64) {
+	s.BoundingBox = val
 }
 
-// SetLineName sets the value of LineName.
-func (s *EntityTrainLeg) SetLineName(val string) {
-	s.LineName = val
+// SetDistanceInKilometers sets the value of DistanceInKilometers.
+func (s *EntityGeocodePlace) SetDistanceInKilometers(val NilFloat64) {
+	s.DistanceInKilometers = val
 }
 
-// SetOperatorName sets the value of OperatorName.
-func (s *EntityTrainLeg) SetOperatorName(val string) {
-	s.OperatorName = val
+// SetLabel sets the value of Label.
+func (s *EntityGeocodePlace) SetLabel(val string) {
+	s.Label = val
 }
 
-// SetOrigin sets the value of Origin.
-func (s *EntityTrainLeg) SetOrigin(val EntityTrainStation) {
-	s.Origin = val
+// SetLatitude sets the value of Latitude.
+func (s *EntityGeocodePlace) SetLatitude(val float64) {
+	s.Latitude = val
 }
 
-// Ref: #/components/schemas/entity.TrainStation
-type EntityTrainStation struct {
-	ID       string         `json:"id"`
-	Location EntityLocation `json:"location"`
-	Name     string         `json:"name"`
+// SetLayer sets the value of Layer.
+func (s *EntityGeocodePlace) SetLayer(val string) {
+	s.Layer = val
 }
 
-// GetID returns the value of ID.
-func (s *EntityTrainStation) GetID() string {
-	return s.ID
+// SetLongitude sets the value of Longitude.
+func (s *EntityGeocodePlace) SetLongitude(val float64) {
+	s.Longitude = val
 }
 
-// GetLocation returns the value of Location.
-func (s *EntityTrainStation) GetLocation() EntityLocation {
-	return s.Location
+// SetName sets the value of Name.
+func (s *EntityGeocodePlace) SetName(val string) {
+	s.Name = val
 }
 
-// GetName returns the value of Name.
-func (s *EntityTrainStation) GetName() string {
-	return s.Name
+// Ref: #/components/schemas/entity.LiveEvent
+type EntityLiveEvent struct {
+	Changes    []EntityWatchChange `json:"changes"`
+	Error      string              `json:"error"`
+	ID         string              `json:"id"`
+	Legs       []EntityWatchLeg    `json:"legs"`
+	OccurredAt string              `json:"occurredAt"`
+	Subject    string              `json:"subject"`
+	Type       EntityLiveEventType `json:"type"`
 }
 
-// SetID sets the value of ID.
-func (s *EntityTrainStation) SetID(val string) {
-	s.ID = val
+// GetChanges returns the value of Changes.
+func (s *EntityLiveEvent) GetChanges() []EntityWatchChange {
+	return s.Changes
 }
 
-// SetLocation sets the value of Location.
-func (s *EntityTrainStation) SetLocation(val EntityLocation) {
-	s.Location = val
+// GetError returns the value of Error.
+func (s *EntityLiveEvent) GetError() string {
+	return s.Error
 }
 
-// SetName sets the value of Name.
-func (s *EntityTrainStation) SetName(val string) {
-	s.Name = val
+// GetID returns the value of ID.
+func (s *EntityLiveEvent) GetID() string {
+	return s.ID
 }
 
-func (*EntityTrainStation) lookupTrainStationRes() {}
+// GetLegs returns the value of Legs.
+func (s *EntityLiveEvent) GetLegs() []EntityWatchLeg {
+	return s.Legs
+}
 
-type EntityTransportationType string
+// GetOccurredAt returns the value of OccurredAt.
+func (s *EntityLiveEvent) GetOccurredAt() string {
+	return s.OccurredAt
+}
 
-type LookupDirectionsBadRequest ResponseError
+// GetSubject returns the value of Subject.
+func (s *EntityLiveEvent) GetSubject() string {
+	return s.Subject
+}
 
-func (*LookupDirectionsBadRequest) lookupDirectionsRes() {}
+// GetType returns the value of Type.
+func (s *EntityLiveEvent) GetType() EntityLiveEventType {
+	return s.Type
+}
 
-type LookupDirectionsInternalServerError ResponseError
+// SetChanges sets the value of Changes.
+func (s *EntityLiveEvent) SetChanges(val []EntityWatchChange) {
+	s.Changes = val
+}
 
-func (*LookupDirectionsInternalServerError) lookupDirectionsRes() {}
+// SetError sets the value of Error.
+func (s *EntityLiveEvent) SetError(val string) {
+	s.Error = val
+}
 
-type LookupDirectionsOK struct{}
+// SetID sets the value of ID.
+func (s *EntityLiveEvent) SetID(val string) {
+	s.ID = val
+}
 
-func (*LookupDirectionsOK) lookupDirectionsRes() {}
+// SetLegs sets the value of Legs.
+func (s *EntityLiveEvent) SetLegs(val []EntityWatchLeg) {
+	s.Legs = val
+}
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
-		Value: v,
-	}
+// SetOccurredAt sets the value of OccurredAt.
+func (s *EntityLiveEvent) SetOccurredAt(val string) {
+	s.OccurredAt = val
 }
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
-	Null  bool
+// SetSubject sets the value of Subject.
+func (s *EntityLiveEvent) SetSubject(val string) {
+	s.Subject = val
 }
 
-// SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
-	o.Null = false
-	o.Value = v
+// SetType sets the value of Type.
+func (s *EntityLiveEvent) SetType(val EntityLiveEventType) {
+	s.Type = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+func (*EntityLiveEvent) streamLiveRes() {}
 
-// SetToNull sets value to null.
-func (o *NilString) SetToNull() {
-	o.Null = true
-	var v string
-	o.Value = v
+type EntityLiveEventType string
+
+// Ref: #/components/schemas/entity.Location
+type EntityLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetLatitude returns the value of Latitude.
+func (s *EntityLocation) GetLatitude() float64 {
+	return s.Latitude
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetLongitude returns the value of Longitude.
+func (s *EntityLocation) GetLongitude() float64 {
+	return s.Longitude
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
+// SetLatitude sets the value of Latitude.
+func (s *EntityLocation) SetLatitude(val float64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *EntityLocation) SetLongitude(val float64) {
+	s.Longitude = val
+}
+
+func (*EntityLocation) lookupLocationRes() {}
+
+// Ref: #/components/schemas/entity.Matrix
+type EntityMatrix struct {
+	DistancesInMeters  [][]float64 `json:"distancesInMeters"`
+	DurationsInSeconds [][]float64 `json:"durationsInSeconds"`
+	Order              []int       `json:"order"`
+}
+
+// GetDistancesInMeters returns the value of DistancesInMeters.
+func (s *EntityMatrix) GetDistancesInMeters() [][]float64 {
+	return s.DistancesInMeters
+}
+
+// GetDurationsInSeconds returns the value of DurationsInSeconds.
+func (s *EntityMatrix) GetDurationsInSeconds() [][]float64 {
+	return s.DurationsInSeconds
+}
+
+// GetOrder returns the value of Order.
+func (s *EntityMatrix) GetOrder() []int {
+	return s.Order
+}
+
+// SetDistancesInMeters sets the value of DistancesInMeters.
+func (s *EntityMatrix) SetDistancesInMeters(val [][]float64) {
+	s.DistancesInMeters = val
+}
+
+// SetDurationsInSeconds sets the value of DurationsInSeconds.
+func (s *EntityMatrix) SetDurationsInSeconds(val [][]float64) {
+	s.DurationsInSeconds = val
+}
+
+// SetOrder sets the value of Order.
+func (s *EntityMatrix) SetOrder(val []int) {
+	s.Order = val
+}
+
+func (*EntityMatrix) lookupMatrixRes() {}
+
+// Ref: #/components/schemas/entity.Poi
+type EntityPoi struct {
+	Category         string    `json:"category"`
+	DistanceInMeters float64   `json:"distanceInMeters"`
+	ID               string    `json:"id"`
+	Latitude         float64   `json:"latitude"`
+	Longitude        float64   `json:"longitude"`
+	Name             string    `json:"name"`
+	OpeningHours     NilString `json:"openingHours"`
+}
+
+// GetCategory returns the value of Category.
+func (s *EntityPoi) GetCategory() string {
+	return s.Category
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityPoi) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetID returns the value of ID.
+func (s *EntityPoi) GetID() string {
+	return s.ID
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *EntityPoi) GetLatitude() float64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *EntityPoi) GetLongitude() float64 {
+	return s.Longitude
+}
+
+// GetName returns the value of Name.
+func (s *EntityPoi) GetName() string {
+	return s.Name
+}
+
+// GetOpeningHours returns the value of OpeningHours.
+func (s *EntityPoi) GetOpeningHours() NilString {
+	return s.OpeningHours
+}
+
+// SetCategory sets the value of Category.
+func (s *EntityPoi) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityPoi) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityPoi) SetID(val string) {
+	s.ID = val
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *EntityPoi) SetLatitude(val float64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *EntityPoi) SetLongitude(val float64) {
+	s.Longitude = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityPoi) SetName(val string) {
+	s.Name = val
+}
+
+// SetOpeningHours sets the value of OpeningHours.
+func (s *EntityPoi) SetOpeningHours(val NilString) {
+	s.OpeningHours = val
+}
+
+// Ref: #/components/schemas/entity.PushSubscription
+type EntityPushSubscription struct {
+	Endpoint string                     `json:"endpoint"`
+	Keys     EntityPushSubscriptionKeys `json:"keys"`
+}
+
+// GetEndpoint returns the value of Endpoint.
+func (s *EntityPushSubscription) GetEndpoint() string {
+	return s.Endpoint
+}
+
+// GetKeys returns the value of Keys.
+func (s *EntityPushSubscription) GetKeys() EntityPushSubscriptionKeys {
+	return s.Keys
+}
+
+// SetEndpoint sets the value of Endpoint.
+func (s *EntityPushSubscription) SetEndpoint(val string) {
+	s.Endpoint = val
+}
+
+// SetKeys sets the value of Keys.
+func (s *EntityPushSubscription) SetKeys(val EntityPushSubscriptionKeys) {
+	s.Keys = val
+}
+
+// Ref: #/components/schemas/entity.PushSubscriptionKeys
+type EntityPushSubscriptionKeys struct {
+	Auth string `json:"auth"`
+	// P256dh is the public key of the user agent, Auth its authentication secret, both encoded as unpadded
+	// base64url.
+	P256dh string `json:"p256dh"`
+}
+
+// GetAuth returns the value of Auth.
+func (s *EntityPushSubscriptionKeys) GetAuth() string {
+	return s.Auth
+}
+
+// GetP256dh returns the value of P256dh.
+func (s *EntityPushSubscriptionKeys) GetP256dh() string {
+	return s.P256dh
+}
+
+// SetAuth sets the value of Auth.
+func (s *EntityPushSubscriptionKeys) SetAuth(val string) {
+	s.Auth = val
+}
+
+// SetP256dh sets the value of P256dh.
+func (s *EntityPushSubscriptionKeys) SetP256dh(val string) {
+	s.P256dh = val
+}
+
+// Ref: #/components/schemas/entity.RoadTrip
+type EntityRoadTrip struct {
+	DistanceInMeters  float64               `json:"distanceInMeters"`
+	DurationInSeconds float64               `json:"durationInSeconds"`
+	Stages            []EntityRoadTripStage `json:"stages"`
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityRoadTrip) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetDurationInSeconds returns the value of DurationInSeconds.
+func (s *EntityRoadTrip) GetDurationInSeconds() float64 {
+	return s.DurationInSeconds
+}
+
+// GetStages returns the value of Stages.
+func (s *EntityRoadTrip) GetStages() []EntityRoadTripStage {
+	return s.Stages
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityRoadTrip) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetDurationInSeconds sets the value of DurationInSeconds.
+func (s *EntityRoadTrip) SetDurationInSeconds(val float64) {
+	s.DurationInSeconds = val
+}
+
+// SetStages sets the value of Stages.
+func (s *EntityRoadTrip) SetStages(val []EntityRoadTripStage) {
+	s.Stages = val
+}
+
+func (*EntityRoadTrip) planRoadTripRes() {}
+
+// Ref: #/components/schemas/entity.RoadTripStage
+type EntityRoadTripStage struct {
+	Day               int                        `json:"day"`
+	DistanceInMeters  float64                    `json:"distanceInMeters"`
+	DurationInSeconds float64                    `json:"durationInSeconds"`
+	End               EntityLocation             `json:"end"`
+	GeoJson           EntityRoadTripStageGeoJson `json:"geoJson"`
+	Overnight         OptEntityGeocodePlace      `json:"overnight"`
+	Start             EntityLocation             `json:"start"`
+}
+
+// GetDay returns the value of Day.
+func (s *EntityRoadTripStage) GetDay() int {
+	return s.Day
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityRoadTripStage) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetDurationInSeconds returns the value of DurationInSeconds.
+func (s *EntityRoadTripStage) GetDurationInSeconds() float64 {
+	return s.DurationInSeconds
+}
+
+// GetEnd returns the value of End.
+func (s *EntityRoadTripStage) GetEnd() EntityLocation {
+	return s.End
+}
+
+// GetGeoJson returns the value of GeoJson.
+func (s *EntityRoadTripStage) GetGeoJson() EntityRoadTripStageGeoJson {
+	return s.GeoJson
+}
+
+// GetOvernight returns the value of Overnight.
+func (s *EntityRoadTripStage) GetOvernight() OptEntityGeocodePlace {
+	return s.Overnight
+}
+
+// GetStart returns the value of Start.
+func (s *EntityRoadTripStage) GetStart() EntityLocation {
+	return s.Start
+}
+
+// SetDay sets the value of Day.
+func (s *EntityRoadTripStage) SetDay(val int) {
+	s.Day = val
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityRoadTripStage) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetDurationInSeconds sets the value of DurationInSeconds.
+func (s *EntityRoadTripStage) SetDurationInSeconds(val float64) {
+	s.DurationInSeconds = val
+}
+
+// SetEnd sets the value of End.
+func (s *EntityRoadTripStage) SetEnd(val EntityLocation) {
+	s.End = val
+}
+
+// SetGeoJson sets the value of GeoJson.
+func (s *EntityRoadTripStage) SetGeoJson(val EntityRoadTripStageGeoJson) {
+	s.GeoJson = val
+}
+
+// SetOvernight sets the value of Overnight.
+func (s *EntityRoadTripStage) SetOvernight(val OptEntityGeocodePlace) {
+	s.Overnight = val
+}
+
+// SetStart sets the value of Start.
+func (s *EntityRoadTripStage) SetStart(val EntityLocation) {
+	s.Start = val
+}
+
+type EntityRoadTripStageGeoJson struct{}
+
+// Ref: #/components/schemas/entity.Route
+type EntityRoute struct {
+	AscentInMeters    NilFloat64             `json:"ascentInMeters"`
+	DescentInMeters   NilFloat64             `json:"descentInMeters"`
+	DistanceInMeters  float64                `json:"distanceInMeters"`
+	DurationInSeconds float64                `json:"durationInSeconds"`
+	ElevationProfile  []EntityElevationPoint `json:"elevationProfile"`
+	Steps             []EntityRouteStep      `json:"steps"`
+}
+
+// GetAscentInMeters returns the value of AscentInMeters.
+func (s *EntityRoute) GetAscentInMeters() NilFloat64 {
+	return s.AscentInMeters
+}
+
+// GetDescentInMeters returns the value of DescentInMeters.
+func (s *EntityRoute) GetDescentInMeters() NilFloat64 {
+	return s.DescentInMeters
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityRoute) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetDurationInSeconds returns the value of DurationInSeconds.
+func (s *EntityRoute) GetDurationInSeconds() float64 {
+	return s.DurationInSeconds
+}
+
+// GetElevationProfile returns the value of ElevationProfile.
+func (s *EntityRoute) GetElevationProfile() []EntityElevationPoint {
+	return s.ElevationProfile
+}
+
+// GetSteps returns the value of Steps.
+func (s *EntityRoute) GetSteps() []EntityRouteStep {
+	return s.Steps
+}
+
+// SetAscentInMeters sets the value of AscentInMeters.
+func (s *EntityRoute) SetAscentInMeters(val NilFloat64) {
+	s.AscentInMeters = val
+}
+
+// SetDescentInMeters sets the value of DescentInMeters.
+func (s *EntityRoute) SetDescentInMeters(val NilFloat64) {
+	s.DescentInMeters = val
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityRoute) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetDurationInSeconds sets the value of DurationInSeconds.
+func (s *EntityRoute) SetDurationInSeconds(val float64) {
+	s.DurationInSeconds = val
+}
+
+// SetElevationProfile sets the value of ElevationProfile.
+func (s *EntityRoute) SetElevationProfile(val []EntityElevationPoint) {
+	s.ElevationProfile = val
+}
+
+// SetSteps sets the value of Steps.
+func (s *EntityRoute) SetSteps(val []EntityRouteStep) {
+	s.Steps = val
+}
+
+// Ref: #/components/schemas/entity.RouteStep
+type EntityRouteStep struct {
+	DistanceInMeters  float64 `json:"distanceInMeters"`
+	DurationInSeconds float64 `json:"durationInSeconds"`
+	Instruction       string  `json:"instruction"`
+	Name              string  `json:"name"`
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityRouteStep) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetDurationInSeconds returns the value of DurationInSeconds.
+func (s *EntityRouteStep) GetDurationInSeconds() float64 {
+	return s.DurationInSeconds
+}
+
+// GetInstruction returns the value of Instruction.
+func (s *EntityRouteStep) GetInstruction() string {
+	return s.Instruction
+}
+
+// GetName returns the value of Name.
+func (s *EntityRouteStep) GetName() string {
+	return s.Name
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityRouteStep) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetDurationInSeconds sets the value of DurationInSeconds.
+func (s *EntityRouteStep) SetDurationInSeconds(val float64) {
+	s.DurationInSeconds = val
+}
+
+// SetInstruction sets the value of Instruction.
+func (s *EntityRouteStep) SetInstruction(val string) {
+	s.Instruction = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityRouteStep) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/entity.ServiceAlert
+type EntityServiceAlert struct {
+	ActivePeriods []EntityAlertPeriod `json:"activePeriods"`
+	Cause         string              `json:"cause"`
+	Description   string              `json:"description"`
+	Effect        string              `json:"effect"`
+	Feed          string              `json:"feed"`
+	Header        string              `json:"header"`
+	ID            string              `json:"id"`
+	RouteIds      []string            `json:"routeIds"`
+	StopIds       []string            `json:"stopIds"`
+	TripIds       []string            `json:"tripIds"`
+	URL           NilString           `json:"url"`
+}
+
+// GetActivePeriods returns the value of ActivePeriods.
+func (s *EntityServiceAlert) GetActivePeriods() []EntityAlertPeriod {
+	return s.ActivePeriods
+}
+
+// GetCause returns the value of Cause.
+func (s *EntityServiceAlert) GetCause() string {
+	return s.Cause
+}
+
+// GetDescription returns the value of Description.
+func (s *EntityServiceAlert) GetDescription() string {
+	return s.Description
+}
+
+// GetEffect returns the value of Effect.
+func (s *EntityServiceAlert) GetEffect() string {
+	return s.Effect
+}
+
+// GetFeed returns the value of Feed.
+func (s *EntityServiceAlert) GetFeed() string {
+	return s.Feed
+}
+
+// GetHeader returns the value of Header.
+func (s *EntityServiceAlert) GetHeader() string {
+	return s.Header
+}
+
+// GetID returns the value of ID.
+func (s *EntityServiceAlert) GetID() string {
+	return s.ID
+}
+
+// GetRouteIds returns the value of RouteIds.
+func (s *EntityServiceAlert) GetRouteIds() []string {
+	return s.RouteIds
+}
+
+// GetStopIds returns the value of StopIds.
+func (s *EntityServiceAlert) GetStopIds() []string {
+	return s.StopIds
+}
+
+// GetTripIds returns the value of TripIds.
+func (s *EntityServiceAlert) GetTripIds() []string {
+	return s.TripIds
+}
+
+// GetURL returns the value of URL.
+func (s *EntityServiceAlert) GetURL() NilString {
+	return s.URL
+}
+
+// SetActivePeriods sets the value of ActivePeriods.
+func (s *EntityServiceAlert) SetActivePeriods(val []EntityAlertPeriod) {
+	s.ActivePeriods = val
+}
+
+// SetCause sets the value of Cause.
+func (s *EntityServiceAlert) SetCause(val string) {
+	s.Cause = val
+}
+
+// SetDescription sets the value of Description.
+func (s *EntityServiceAlert) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetEffect sets the value of Effect.
+func (s *EntityServiceAlert) SetEffect(val string) {
+	s.Effect = val
+}
+
+// SetFeed sets the value of Feed.
+func (s *EntityServiceAlert) SetFeed(val string) {
+	s.Feed = val
+}
+
+// SetHeader sets the value of Header.
+func (s *EntityServiceAlert) SetHeader(val string) {
+	s.Header = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityServiceAlert) SetID(val string) {
+	s.ID = val
+}
+
+// SetRouteIds sets the value of RouteIds.
+func (s *EntityServiceAlert) SetRouteIds(val []string) {
+	s.RouteIds = val
+}
+
+// SetStopIds sets the value of StopIds.
+func (s *EntityServiceAlert) SetStopIds(val []string) {
+	s.StopIds = val
+}
+
+// SetTripIds sets the value of TripIds.
+func (s *EntityServiceAlert) SetTripIds(val []string) {
+	s.TripIds = val
+}
+
+// SetURL sets the value of URL.
+func (s *EntityServiceAlert) SetURL(val NilString) {
+	s.URL = val
+}
+
+// Ref: #/components/schemas/entity.Train
+type EntityTrain struct {
+	GeoJson      EntityTrainGeoJson    `json:"geoJson"`
+	Legs         []EntityTrainLeg      `json:"legs"`
+	RefreshToken string                `json:"refreshToken"`
+	Transfers    []EntityTrainTransfer `json:"transfers"`
+}
+
+// GetGeoJson returns the value of GeoJson.
+func (s *EntityTrain) GetGeoJson() EntityTrainGeoJson {
+	return s.GeoJson
+}
+
+// GetLegs returns the value of Legs.
+func (s *EntityTrain) GetLegs() []EntityTrainLeg {
+	return s.Legs
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *EntityTrain) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// GetTransfers returns the value of Transfers.
+func (s *EntityTrain) GetTransfers() []EntityTrainTransfer {
+	return s.Transfers
+}
+
+// SetGeoJson sets the value of GeoJson.
+func (s *EntityTrain) SetGeoJson(val EntityTrainGeoJson) {
+	s.GeoJson = val
+}
+
+// SetLegs sets the value of Legs.
+func (s *EntityTrain) SetLegs(val []EntityTrainLeg) {
+	s.Legs = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *EntityTrain) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// SetTransfers sets the value of Transfers.
+func (s *EntityTrain) SetTransfers(val []EntityTrainTransfer) {
+	s.Transfers = val
+}
+
+func (*EntityTrain) postTrainJourneyRes()     {}
+func (*EntityTrain) postTrainTripSectionRes() {}
+
+type EntityTrainGeoJson struct{}
+
+// Ref: #/components/schemas/entity.TrainLeg
+type EntityTrainLeg struct {
+	ArrivalDateTime           string                `json:"arrivalDateTime"`
+	ArrivalPlatform           NilString             `json:"arrivalPlatform"`
+	Cancelled                 bool                  `json:"cancelled"`
+	DepartureDateTime         string                `json:"departureDateTime"`
+	DeparturePlatform         NilString             `json:"departurePlatform"`
+	Destination               EntityTrainStation    `json:"destination"`
+	DurationInMinutes         int                   `json:"durationInMinutes"`
+	LineName                  string                `json:"lineName"`
+	OperatorName              string                `json:"operatorName"`
+	Origin                    EntityTrainStation    `json:"origin"`
+	RealtimeArrivalDateTime   NilString             `json:"realtimeArrivalDateTime"`
+	RealtimeDepartureDateTime NilString             `json:"realtimeDepartureDateTime"`
+	Stopovers                 []EntityTrainStopover `json:"stopovers"`
+	TripId                    string                `json:"tripId"`
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTrainLeg) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetArrivalPlatform returns the value of ArrivalPlatform.
+func (s *EntityTrainLeg) GetArrivalPlatform() NilString {
+	return s.ArrivalPlatform
+}
+
+// GetCancelled returns the value of Cancelled.
+func (s *EntityTrainLeg) GetCancelled() bool {
+	return s.Cancelled
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTrainLeg) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetDeparturePlatform returns the value of DeparturePlatform.
+func (s *EntityTrainLeg) GetDeparturePlatform() NilString {
+	return s.DeparturePlatform
+}
+
+// GetDestination returns the value of Destination.
+func (s *EntityTrainLeg) GetDestination() EntityTrainStation {
+	return s.Destination
+}
+
+// GetDurationInMinutes returns the value of DurationInMinutes.
+func (s *EntityTrainLeg) GetDurationInMinutes() int {
+	return s.DurationInMinutes
+}
+
+// GetLineName returns the value of LineName.
+func (s *EntityTrainLeg) GetLineName() string {
+	return s.LineName
+}
+
+// GetOperatorName returns the value of OperatorName.
+func (s *EntityTrainLeg) GetOperatorName() string {
+	return s.OperatorName
+}
+
+// GetOrigin returns the value of Origin.
+func (s *EntityTrainLeg) GetOrigin() EntityTrainStation {
+	return s.Origin
+}
+
+// GetRealtimeArrivalDateTime returns the value of RealtimeArrivalDateTime.
+func (s *EntityTrainLeg) GetRealtimeArrivalDateTime() NilString {
+	return s.RealtimeArrivalDateTime
+}
+
+// GetRealtimeDepartureDateTime returns the value of RealtimeDepartureDateTime.
+func (s *EntityTrainLeg) GetRealtimeDepartureDateTime() NilString {
+	return s.RealtimeDepartureDateTime
+}
+
+// GetStopovers returns the value of Stopovers.
+func (s *EntityTrainLeg) GetStopovers() []EntityTrainStopover {
+	return s.Stopovers
+}
+
+// GetTripId returns the value of TripId.
+func (s *EntityTrainLeg) GetTripId() string {
+	return s.TripId
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTrainLeg) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetArrivalPlatform sets the value of ArrivalPlatform.
+func (s *EntityTrainLeg) SetArrivalPlatform(val NilString) {
+	s.ArrivalPlatform = val
+}
+
+// SetCancelled sets the value of Cancelled.
+func (s *EntityTrainLeg) SetCancelled(val bool) {
+	s.Cancelled = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTrainLeg) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDeparturePlatform sets the value of DeparturePlatform.
+func (s *EntityTrainLeg) SetDeparturePlatform(val NilString) {
+	s.DeparturePlatform = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityTrainLeg) SetDestination(val EntityTrainStation) {
+	s.Destination = val
+}
+
+// SetDurationInMinutes sets the value of DurationInMinutes.
+func (s *EntityTrainLeg) SetDurationInMinutes(val int) {
+	s.DurationInMinutes = val
+}
+
+// SetLineName sets the value of LineName.
+func (s *EntityTrainLeg) SetLineName(val string) {
+	s.LineName = val
+}
+
+// SetOperatorName sets the value of OperatorName.
+func (s *EntityTrainLeg) SetOperatorName(val string) {
+	s.OperatorName = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityTrainLeg) SetOrigin(val EntityTrainStation) {
+	s.Origin = val
+}
+
+// SetRealtimeArrivalDateTime sets the value of RealtimeArrivalDateTime.
+func (s *EntityTrainLeg) SetRealtimeArrivalDateTime(val NilString) {
+	s.RealtimeArrivalDateTime = val
+}
+
+// SetRealtimeDepartureDateTime sets the value of RealtimeDepartureDateTime.
+func (s *EntityTrainLeg) SetRealtimeDepartureDateTime(val NilString) {
+	s.RealtimeDepartureDateTime = val
+}
+
+// SetStopovers sets the value of Stopovers.
+func (s *EntityTrainLeg) SetStopovers(val []EntityTrainStopover) {
+	s.Stopovers = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *EntityTrainLeg) SetTripId(val string) {
+	s.TripId = val
+}
+
+// Ref: #/components/schemas/entity.TrainStation
+type EntityTrainStation struct {
+	ID       string         `json:"id"`
+	Location EntityLocation `json:"location"`
+	Name     string         `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *EntityTrainStation) GetID() string {
+	return s.ID
+}
+
+// GetLocation returns the value of Location.
+func (s *EntityTrainStation) GetLocation() EntityLocation {
+	return s.Location
+}
+
+// GetName returns the value of Name.
+func (s *EntityTrainStation) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *EntityTrainStation) SetID(val string) {
+	s.ID = val
+}
+
+// SetLocation sets the value of Location.
+func (s *EntityTrainStation) SetLocation(val EntityLocation) {
+	s.Location = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityTrainStation) SetName(val string) {
+	s.Name = val
+}
+
+func (*EntityTrainStation) lookupTrainStationRes() {}
+
+// Ref: #/components/schemas/entity.TrainStopover
+type EntityTrainStopover struct {
+	ArrivalDateTime           NilString          `json:"arrivalDateTime"`
+	Cancelled                 bool               `json:"cancelled"`
+	DepartureDateTime         NilString          `json:"departureDateTime"`
+	Platform                  NilString          `json:"platform"`
+	RealtimeArrivalDateTime   NilString          `json:"realtimeArrivalDateTime"`
+	RealtimeDepartureDateTime NilString          `json:"realtimeDepartureDateTime"`
+	Station                   EntityTrainStation `json:"station"`
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTrainStopover) GetArrivalDateTime() NilString {
+	return s.ArrivalDateTime
+}
+
+// GetCancelled returns the value of Cancelled.
+func (s *EntityTrainStopover) GetCancelled() bool {
+	return s.Cancelled
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTrainStopover) GetDepartureDateTime() NilString {
+	return s.DepartureDateTime
+}
+
+// GetPlatform returns the value of Platform.
+func (s *EntityTrainStopover) GetPlatform() NilString {
+	return s.Platform
+}
+
+// GetRealtimeArrivalDateTime returns the value of RealtimeArrivalDateTime.
+func (s *EntityTrainStopover) GetRealtimeArrivalDateTime() NilString {
+	return s.RealtimeArrivalDateTime
+}
+
+// GetRealtimeDepartureDateTime returns the value of RealtimeDepartureDateTime.
+func (s *EntityTrainStopover) GetRealtimeDepartureDateTime() NilString {
+	return s.RealtimeDepartureDateTime
+}
+
+// GetStation returns the value of Station.
+func (s *EntityTrainStopover) GetStation() EntityTrainStation {
+	return s.Station
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTrainStopover) SetArrivalDateTime(val NilString) {
+	s.ArrivalDateTime = val
+}
+
+// SetCancelled sets the value of Cancelled.
+func (s *EntityTrainStopover) SetCancelled(val bool) {
+	s.Cancelled = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTrainStopover) SetDepartureDateTime(val NilString) {
+	s.DepartureDateTime = val
+}
+
+// SetPlatform sets the value of Platform.
+func (s *EntityTrainStopover) SetPlatform(val NilString) {
+	s.Platform = val
+}
+
+// SetRealtimeArrivalDateTime sets the value of RealtimeArrivalDateTime.
+func (s *EntityTrainStopover) SetRealtimeArrivalDateTime(val NilString) {
+	s.RealtimeArrivalDateTime = val
+}
+
+// SetRealtimeDepartureDateTime sets the value of RealtimeDepartureDateTime.
+func (s *EntityTrainStopover) SetRealtimeDepartureDateTime(val NilString) {
+	s.RealtimeDepartureDateTime = val
+}
+
+// SetStation sets the value of Station.
+func (s *EntityTrainStopover) SetStation(val EntityTrainStation) {
+	s.Station = val
+}
+
+// Ref: #/components/schemas/entity.TrainTransfer
+type EntityTrainTransfer struct {
+	ArrivalDateTime   string                       `json:"arrivalDateTime"`
+	DepartureDateTime string                       `json:"departureDateTime"`
+	Destination       EntityTrainStation           `json:"destination"`
+	DistanceInMeters  NilInt                       `json:"distanceInMeters"`
+	DurationInMinutes int                          `json:"durationInMinutes"`
+	Geometry          *EntityTrainTransferGeometry `json:"geometry"`
+	Origin            EntityTrainStation           `json:"origin"`
+	Type              EntityTrainTransferType      `json:"type"`
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTrainTransfer) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTrainTransfer) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetDestination returns the value of Destination.
+func (s *EntityTrainTransfer) GetDestination() EntityTrainStation {
+	return s.Destination
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityTrainTransfer) GetDistanceInMeters() NilInt {
+	return s.DistanceInMeters
+}
+
+// GetDurationInMinutes returns the value of DurationInMinutes.
+func (s *EntityTrainTransfer) GetDurationInMinutes() int {
+	return s.DurationInMinutes
+}
+
+// GetGeometry returns the value of Geometry.
+func (s *EntityTrainTransfer) GetGeometry() *EntityTrainTransferGeometry {
+	return s.Geometry
+}
+
+// GetOrigin returns the value of Origin.
+func (s *EntityTrainTransfer) GetOrigin() EntityTrainStation {
+	return s.Origin
+}
+
+// GetType returns the value of Type.
+func (s *EntityTrainTransfer) GetType() EntityTrainTransferType {
+	return s.Type
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTrainTransfer) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTrainTransfer) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityTrainTransfer) SetDestination(val EntityTrainStation) {
+	s.Destination = val
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityTrainTransfer) SetDistanceInMeters(val NilInt) {
+	s.DistanceInMeters = val
+}
+
+// SetDurationInMinutes sets the value of DurationInMinutes.
+func (s *EntityTrainTransfer) SetDurationInMinutes(val int) {
+	s.DurationInMinutes = val
+}
+
+// SetGeometry sets the value of Geometry.
+func (s *EntityTrainTransfer) SetGeometry(val *EntityTrainTransferGeometry) {
+	s.Geometry = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityTrainTransfer) SetOrigin(val EntityTrainStation) {
+	s.Origin = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityTrainTransfer) SetType(val EntityTrainTransferType) {
+	s.Type = val
+}
+
+type EntityTrainTransferGeometry struct{}
+
+type EntityTrainTransferType string
+
+// Ref: #/components/schemas/entity.TrainTrip
+type EntityTrainTrip struct {
+	ID           string                `json:"id"`
+	LineName     string                `json:"lineName"`
+	OperatorName string                `json:"operatorName"`
+	Stopovers    []EntityTrainStopover `json:"stopovers"`
+}
+
+// GetID returns the value of ID.
+func (s *EntityTrainTrip) GetID() string {
+	return s.ID
+}
+
+// GetLineName returns the value of LineName.
+func (s *EntityTrainTrip) GetLineName() string {
+	return s.LineName
+}
+
+// GetOperatorName returns the value of OperatorName.
+func (s *EntityTrainTrip) GetOperatorName() string {
+	return s.OperatorName
+}
+
+// GetStopovers returns the value of Stopovers.
+func (s *EntityTrainTrip) GetStopovers() []EntityTrainStopover {
+	return s.Stopovers
+}
+
+// SetID sets the value of ID.
+func (s *EntityTrainTrip) SetID(val string) {
+	s.ID = val
+}
+
+// SetLineName sets the value of LineName.
+func (s *EntityTrainTrip) SetLineName(val string) {
+	s.LineName = val
+}
+
+// SetOperatorName sets the value of OperatorName.
+func (s *EntityTrainTrip) SetOperatorName(val string) {
+	s.OperatorName = val
+}
+
+// SetStopovers sets the value of Stopovers.
+func (s *EntityTrainTrip) SetStopovers(val []EntityTrainStopover) {
+	s.Stopovers = val
+}
+
+func (*EntityTrainTrip) postTrainTripRes() {}
+
+// Ref: #/components/schemas/entity.Transit
+type EntityTransit struct {
+	GeoJson EntityTransitGeoJson `json:"geoJson"`
+	Legs    []EntityTransitLeg   `json:"legs"`
+}
+
+// GetGeoJson returns the value of GeoJson.
+func (s *EntityTransit) GetGeoJson() EntityTransitGeoJson {
+	return s.GeoJson
+}
+
+// GetLegs returns the value of Legs.
+func (s *EntityTransit) GetLegs() []EntityTransitLeg {
+	return s.Legs
+}
+
+// SetGeoJson sets the value of GeoJson.
+func (s *EntityTransit) SetGeoJson(val EntityTransitGeoJson) {
+	s.GeoJson = val
+}
+
+// SetLegs sets the value of Legs.
+func (s *EntityTransit) SetLegs(val []EntityTransitLeg) {
+	s.Legs = val
+}
+
+func (*EntityTransit) postTransitRes() {}
+
+type EntityTransitGeoJson struct{}
+
+// Ref: #/components/schemas/entity.TransitLeg
+type EntityTransitLeg struct {
+	AgencyName                string                   `json:"agencyName"`
+	ArrivalDateTime           string                   `json:"arrivalDateTime"`
+	Cancelled                 bool                     `json:"cancelled"`
+	DepartureDateTime         string                   `json:"departureDateTime"`
+	Destination               EntityTransitStop        `json:"destination"`
+	DurationInMinutes         int                      `json:"durationInMinutes"`
+	Feed                      string                   `json:"feed"`
+	Headsign                  string                   `json:"headsign"`
+	Origin                    EntityTransitStop        `json:"origin"`
+	RealtimeArrivalDateTime   NilString                `json:"realtimeArrivalDateTime"`
+	RealtimeDepartureDateTime NilString                `json:"realtimeDepartureDateTime"`
+	RouteId                   string                   `json:"routeId"`
+	RouteName                 string                   `json:"routeName"`
+	Stopovers                 []EntityTransitStopover  `json:"stopovers"`
+	Timezone                  string                   `json:"timezone"`
+	TripId                    string                   `json:"tripId"`
+	Type                      string                   `json:"type"`
+	Vehicle                   OptEntityVehiclePosition `json:"vehicle"`
+}
+
+// GetAgencyName returns the value of AgencyName.
+func (s *EntityTransitLeg) GetAgencyName() string {
+	return s.AgencyName
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTransitLeg) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetCancelled returns the value of Cancelled.
+func (s *EntityTransitLeg) GetCancelled() bool {
+	return s.Cancelled
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTransitLeg) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetDestination returns the value of Destination.
+func (s *EntityTransitLeg) GetDestination() EntityTransitStop {
+	return s.Destination
+}
+
+// GetDurationInMinutes returns the value of DurationInMinutes.
+func (s *EntityTransitLeg) GetDurationInMinutes() int {
+	return s.DurationInMinutes
+}
+
+// GetFeed returns the value of Feed.
+func (s *EntityTransitLeg) GetFeed() string {
+	return s.Feed
+}
+
+// GetHeadsign returns the value of Headsign.
+func (s *EntityTransitLeg) GetHeadsign() string {
+	return s.Headsign
+}
+
+// GetOrigin returns the value of Origin.
+func (s *EntityTransitLeg) GetOrigin() EntityTransitStop {
+	return s.Origin
+}
+
+// GetRealtimeArrivalDateTime returns the value of RealtimeArrivalDateTime.
+func (s *EntityTransitLeg) GetRealtimeArrivalDateTime() NilString {
+	return s.RealtimeArrivalDateTime
+}
+
+// GetRealtimeDepartureDateTime returns the value of RealtimeDepartureDateTime.
+func (s *EntityTransitLeg) GetRealtimeDepartureDateTime() NilString {
+	return s.RealtimeDepartureDateTime
+}
+
+// GetRouteId returns the value of RouteId.
+func (s *EntityTransitLeg) GetRouteId() string {
+	return s.RouteId
+}
+
+// GetRouteName returns the value of RouteName.
+func (s *EntityTransitLeg) GetRouteName() string {
+	return s.RouteName
+}
+
+// GetStopovers returns the value of Stopovers.
+func (s *EntityTransitLeg) GetStopovers() []EntityTransitStopover {
+	return s.Stopovers
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *EntityTransitLeg) GetTimezone() string {
+	return s.Timezone
+}
+
+// GetTripId returns the value of TripId.
+func (s *EntityTransitLeg) GetTripId() string {
+	return s.TripId
+}
+
+// GetType returns the value of Type.
+func (s *EntityTransitLeg) GetType() string {
+	return s.Type
+}
+
+// GetVehicle returns the value of Vehicle.
+func (s *EntityTransitLeg) GetVehicle() OptEntityVehiclePosition {
+	return s.Vehicle
+}
+
+// SetAgencyName sets the value of AgencyName.
+func (s *EntityTransitLeg) SetAgencyName(val string) {
+	s.AgencyName = val
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTransitLeg) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetCancelled sets the value of Cancelled.
+func (s *EntityTransitLeg) SetCancelled(val bool) {
+	s.Cancelled = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTransitLeg) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityTransitLeg) SetDestination(val EntityTransitStop) {
+	s.Destination = val
+}
+
+// SetDurationInMinutes sets the value of DurationInMinutes.
+func (s *EntityTransitLeg) SetDurationInMinutes(val int) {
+	s.DurationInMinutes = val
+}
+
+// SetFeed sets the value of Feed.
+func (s *EntityTransitLeg) SetFeed(val string) {
+	s.Feed = val
+}
+
+// SetHeadsign sets the value of Headsign.
+func (s *EntityTransitLeg) SetHeadsign(val string) {
+	s.Headsign = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityTransitLeg) SetOrigin(val EntityTransitStop) {
+	s.Origin = val
+}
+
+// SetRealtimeArrivalDateTime sets the value of RealtimeArrivalDateTime.
+func (s *EntityTransitLeg) SetRealtimeArrivalDateTime(val NilString) {
+	s.RealtimeArrivalDateTime = val
+}
+
+// SetRealtimeDepartureDateTime sets the value of RealtimeDepartureDateTime.
+func (s *EntityTransitLeg) SetRealtimeDepartureDateTime(val NilString) {
+	s.RealtimeDepartureDateTime = val
+}
+
+// SetRouteId sets the value of RouteId.
+func (s *EntityTransitLeg) SetRouteId(val string) {
+	s.RouteId = val
+}
+
+// SetRouteName sets the value of RouteName.
+func (s *EntityTransitLeg) SetRouteName(val string) {
+	s.RouteName = val
+}
+
+// SetStopovers sets the value of Stopovers.
+func (s *EntityTransitLeg) SetStopovers(val []EntityTransitStopover) {
+	s.Stopovers = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *EntityTransitLeg) SetTimezone(val string) {
+	s.Timezone = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *EntityTransitLeg) SetTripId(val string) {
+	s.TripId = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityTransitLeg) SetType(val string) {
+	s.Type = val
+}
+
+// SetVehicle sets the value of Vehicle.
+func (s *EntityTransitLeg) SetVehicle(val OptEntityVehiclePosition) {
+	s.Vehicle = val
+}
+
+// Ref: #/components/schemas/entity.TransitStop
+type EntityTransitStop struct {
+	Feed     string         `json:"feed"`
+	ID       string         `json:"id"`
+	Location EntityLocation `json:"location"`
+	Name     string         `json:"name"`
+}
+
+// GetFeed returns the value of Feed.
+func (s *EntityTransitStop) GetFeed() string {
+	return s.Feed
+}
+
+// GetID returns the value of ID.
+func (s *EntityTransitStop) GetID() string {
+	return s.ID
+}
+
+// GetLocation returns the value of Location.
+func (s *EntityTransitStop) GetLocation() EntityLocation {
+	return s.Location
+}
+
+// GetName returns the value of Name.
+func (s *EntityTransitStop) GetName() string {
+	return s.Name
+}
+
+// SetFeed sets the value of Feed.
+func (s *EntityTransitStop) SetFeed(val string) {
+	s.Feed = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityTransitStop) SetID(val string) {
+	s.ID = val
+}
+
+// SetLocation sets the value of Location.
+func (s *EntityTransitStop) SetLocation(val EntityLocation) {
+	s.Location = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityTransitStop) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/entity.TransitStopover
+type EntityTransitStopover struct {
+	ArrivalDateTime           string            `json:"arrivalDateTime"`
+	Cancelled                 bool              `json:"cancelled"`
+	DepartureDateTime         string            `json:"departureDateTime"`
+	RealtimeArrivalDateTime   NilString         `json:"realtimeArrivalDateTime"`
+	RealtimeDepartureDateTime NilString         `json:"realtimeDepartureDateTime"`
+	Stop                      EntityTransitStop `json:"stop"`
+	StopSequence              int               `json:"stopSequence"`
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTransitStopover) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetCancelled returns the value of Cancelled.
+func (s *EntityTransitStopover) GetCancelled() bool {
+	return s.Cancelled
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTransitStopover) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetRealtimeArrivalDateTime returns the value of RealtimeArrivalDateTime.
+func (s *EntityTransitStopover) GetRealtimeArrivalDateTime() NilString {
+	return s.RealtimeArrivalDateTime
+}
+
+// GetRealtimeDepartureDateTime returns the value of RealtimeDepartureDateTime.
+func (s *EntityTransitStopover) GetRealtimeDepartureDateTime() NilString {
+	return s.RealtimeDepartureDateTime
+}
+
+// GetStop returns the value of Stop.
+func (s *EntityTransitStopover) GetStop() EntityTransitStop {
+	return s.Stop
+}
+
+// GetStopSequence returns the value of StopSequence.
+func (s *EntityTransitStopover) GetStopSequence() int {
+	return s.StopSequence
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTransitStopover) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetCancelled sets the value of Cancelled.
+func (s *EntityTransitStopover) SetCancelled(val bool) {
+	s.Cancelled = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTransitStopover) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetRealtimeArrivalDateTime sets the value of RealtimeArrivalDateTime.
+func (s *EntityTransitStopover) SetRealtimeArrivalDateTime(val NilString) {
+	s.RealtimeArrivalDateTime = val
+}
+
+// SetRealtimeDepartureDateTime sets the value of RealtimeDepartureDateTime.
+func (s *EntityTransitStopover) SetRealtimeDepartureDateTime(val NilString) {
+	s.RealtimeDepartureDateTime = val
+}
+
+// SetStop sets the value of Stop.
+func (s *EntityTransitStopover) SetStop(val EntityTransitStop) {
+	s.Stop = val
+}
+
+// SetStopSequence sets the value of StopSequence.
+func (s *EntityTransitStopover) SetStopSequence(val int) {
+	s.StopSequence = val
+}
+
+type EntityTransportationType string
+
+// Ref: #/components/schemas/entity.VapidKey
+type EntityVapidKey struct {
+	// PublicKey is the application server key for PushManager.subscribe(), encoded as unpadded base64url.
+	PublicKey string `json:"publicKey"`
+}
+
+// GetPublicKey returns the value of PublicKey.
+func (s *EntityVapidKey) GetPublicKey() string {
+	return s.PublicKey
+}
+
+// SetPublicKey sets the value of PublicKey.
+func (s *EntityVapidKey) SetPublicKey(val string) {
+	s.PublicKey = val
+}
+
+func (*EntityVapidKey) retrieveVapidKeyRes() {}
+
+// Ref: #/components/schemas/entity.VehiclePosition
+type EntityVehiclePosition struct {
+	Bearing   NilFloat64     `json:"bearing"`
+	Location  EntityLocation `json:"location"`
+	StopId    NilString      `json:"stopId"`
+	Timestamp string         `json:"timestamp"`
+	TripId    string         `json:"tripId"`
+}
+
+// GetBearing returns the value of Bearing.
+func (s *EntityVehiclePosition) GetBearing() NilFloat64 {
+	return s.Bearing
+}
+
+// GetLocation returns the value of Location.
+func (s *EntityVehiclePosition) GetLocation() EntityLocation {
+	return s.Location
+}
+
+// GetStopId returns the value of StopId.
+func (s *EntityVehiclePosition) GetStopId() NilString {
+	return s.StopId
+}
+
+// GetTimestamp returns the value of Timestamp.
+func (s *EntityVehiclePosition) GetTimestamp() string {
+	return s.Timestamp
+}
+
+// GetTripId returns the value of TripId.
+func (s *EntityVehiclePosition) GetTripId() string {
+	return s.TripId
+}
+
+// SetBearing sets the value of Bearing.
+func (s *EntityVehiclePosition) SetBearing(val NilFloat64) {
+	s.Bearing = val
+}
+
+// SetLocation sets the value of Location.
+func (s *EntityVehiclePosition) SetLocation(val EntityLocation) {
+	s.Location = val
+}
+
+// SetStopId sets the value of StopId.
+func (s *EntityVehiclePosition) SetStopId(val NilString) {
+	s.StopId = val
+}
+
+// SetTimestamp sets the value of Timestamp.
+func (s *EntityVehiclePosition) SetTimestamp(val string) {
+	s.Timestamp = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *EntityVehiclePosition) SetTripId(val string) {
+	s.TripId = val
+}
+
+// Ref: #/components/schemas/entity.Watch
+type EntityWatch struct {
+	CheckedAt        string                    `json:"checkedAt"`
+	CreatedAt        string                    `json:"createdAt"`
+	Flight           OptEntityWatchedFlight    `json:"flight"`
+	ID               string                    `json:"id"`
+	Legs             []EntityWatchLeg          `json:"legs"`
+	NextCheckAt      string                    `json:"nextCheckAt"`
+	PushSubscription OptEntityPushSubscription `json:"pushSubscription"`
+	SubscriberKey    string                    `json:"subscriberKey"`
+	Train            OptEntityWatchedTrain     `json:"train"`
+	WebhookUrl       string                    `json:"webhookUrl"`
+}
+
+// GetCheckedAt returns the value of CheckedAt.
+func (s *EntityWatch) GetCheckedAt() string {
+	return s.CheckedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *EntityWatch) GetCreatedAt() string {
+	return s.CreatedAt
+}
+
+// GetFlight returns the value of Flight.
+func (s *EntityWatch) GetFlight() OptEntityWatchedFlight {
+	return s.Flight
+}
+
+// GetID returns the value of ID.
+func (s *EntityWatch) GetID() string {
+	return s.ID
+}
+
+// GetLegs returns the value of Legs.
+func (s *EntityWatch) GetLegs() []EntityWatchLeg {
+	return s.Legs
+}
+
+// GetNextCheckAt returns the value of NextCheckAt.
+func (s *EntityWatch) GetNextCheckAt() string {
+	return s.NextCheckAt
+}
+
+// GetPushSubscription returns the value of PushSubscription.
+func (s *EntityWatch) GetPushSubscription() OptEntityPushSubscription {
+	return s.PushSubscription
+}
+
+// GetSubscriberKey returns the value of SubscriberKey.
+func (s *EntityWatch) GetSubscriberKey() string {
+	return s.SubscriberKey
+}
+
+// GetTrain returns the value of Train.
+func (s *EntityWatch) GetTrain() OptEntityWatchedTrain {
+	return s.Train
+}
+
+// GetWebhookUrl returns the value of WebhookUrl.
+func (s *EntityWatch) GetWebhookUrl() string {
+	return s.WebhookUrl
+}
+
+// SetCheckedAt sets the value of CheckedAt.
+func (s *EntityWatch) SetCheckedAt(val string) {
+	s.CheckedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *EntityWatch) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
+
+// SetFlight sets the value of Flight.
+func (s *EntityWatch) SetFlight(val OptEntityWatchedFlight) {
+	s.Flight = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityWatch) SetID(val string) {
+	s.ID = val
+}
+
+// SetLegs sets the value of Legs.
+func (s *EntityWatch) SetLegs(val []EntityWatchLeg) {
+	s.Legs = val
+}
+
+// SetNextCheckAt sets the value of NextCheckAt.
+func (s *EntityWatch) SetNextCheckAt(val string) {
+	s.NextCheckAt = val
+}
+
+// SetPushSubscription sets the value of PushSubscription.
+func (s *EntityWatch) SetPushSubscription(val OptEntityPushSubscription) {
+	s.PushSubscription = val
+}
+
+// SetSubscriberKey sets the value of SubscriberKey.
+func (s *EntityWatch) SetSubscriberKey(val string) {
+	s.SubscriberKey = val
+}
+
+// SetTrain sets the value of Train.
+func (s *EntityWatch) SetTrain(val OptEntityWatchedTrain) {
+	s.Train = val
+}
+
+// SetWebhookUrl sets the value of WebhookUrl.
+func (s *EntityWatch) SetWebhookUrl(val string) {
+	s.WebhookUrl = val
+}
+
+func (*EntityWatch) createWatchRes()   {}
+func (*EntityWatch) retrieveWatchRes() {}
+
+// Ref: #/components/schemas/entity.WatchChange
+type EntityWatchChange struct {
+	Current  NilString `json:"current"`
+	Field    string    `json:"field"`
+	Leg      int       `json:"leg"`
+	Previous NilString `json:"previous"`
+}
+
+// GetCurrent returns the value of Current.
+func (s *EntityWatchChange) GetCurrent() NilString {
+	return s.Current
+}
+
+// GetField returns the value of Field.
+func (s *EntityWatchChange) GetField() string {
+	return s.Field
+}
+
+// GetLeg returns the value of Leg.
+func (s *EntityWatchChange) GetLeg() int {
+	return s.Leg
+}
+
+// GetPrevious returns the value of Previous.
+func (s *EntityWatchChange) GetPrevious() NilString {
+	return s.Previous
+}
+
+// SetCurrent sets the value of Current.
+func (s *EntityWatchChange) SetCurrent(val NilString) {
+	s.Current = val
+}
+
+// SetField sets the value of Field.
+func (s *EntityWatchChange) SetField(val string) {
+	s.Field = val
+}
+
+// SetLeg sets the value of Leg.
+func (s *EntityWatchChange) SetLeg(val int) {
+	s.Leg = val
+}
+
+// SetPrevious sets the value of Previous.
+func (s *EntityWatchChange) SetPrevious(val NilString) {
+	s.Previous = val
+}
+
+// Ref: #/components/schemas/entity.WatchLeg
+type EntityWatchLeg struct {
+	Aircraft                  NilString `json:"aircraft"`
+	ArrivalDateTime           string    `json:"arrivalDateTime"`
+	ArrivalPlatform           NilString `json:"arrivalPlatform"`
+	Cancelled                 bool      `json:"cancelled"`
+	DepartureDateTime         string    `json:"departureDateTime"`
+	DeparturePlatform         NilString `json:"departurePlatform"`
+	Destination               string    `json:"destination"`
+	Origin                    string    `json:"origin"`
+	RealtimeArrivalDateTime   NilString `json:"realtimeArrivalDateTime"`
+	RealtimeDepartureDateTime NilString `json:"realtimeDepartureDateTime"`
+}
+
+// GetAircraft returns the value of Aircraft.
+func (s *EntityWatchLeg) GetAircraft() NilString {
+	return s.Aircraft
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityWatchLeg) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetArrivalPlatform returns the value of ArrivalPlatform.
+func (s *EntityWatchLeg) GetArrivalPlatform() NilString {
+	return s.ArrivalPlatform
+}
+
+// GetCancelled returns the value of Cancelled.
+func (s *EntityWatchLeg) GetCancelled() bool {
+	return s.Cancelled
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityWatchLeg) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetDeparturePlatform returns the value of DeparturePlatform.
+func (s *EntityWatchLeg) GetDeparturePlatform() NilString {
+	return s.DeparturePlatform
+}
+
+// GetDestination returns the value of Destination.
+func (s *EntityWatchLeg) GetDestination() string {
+	return s.Destination
+}
+
+// GetOrigin returns the value of Origin.
+func (s *EntityWatchLeg) GetOrigin() string {
+	return s.Origin
+}
+
+// GetRealtimeArrivalDateTime returns the value of RealtimeArrivalDateTime.
+func (s *EntityWatchLeg) GetRealtimeArrivalDateTime() NilString {
+	return s.RealtimeArrivalDateTime
+}
+
+// GetRealtimeDepartureDateTime returns the value of RealtimeDepartureDateTime.
+func (s *EntityWatchLeg) GetRealtimeDepartureDateTime() NilString {
+	return s.RealtimeDepartureDateTime
+}
+
+// SetAircraft sets the value of Aircraft.
+func (s *EntityWatchLeg) SetAircraft(val NilString) {
+	s.Aircraft = val
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityWatchLeg) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetArrivalPlatform sets the value of ArrivalPlatform.
+func (s *EntityWatchLeg) SetArrivalPlatform(val NilString) {
+	s.ArrivalPlatform = val
+}
+
+// SetCancelled sets the value of Cancelled.
+func (s *EntityWatchLeg) SetCancelled(val bool) {
+	s.Cancelled = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityWatchLeg) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDeparturePlatform sets the value of DeparturePlatform.
+func (s *EntityWatchLeg) SetDeparturePlatform(val NilString) {
+	s.DeparturePlatform = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityWatchLeg) SetDestination(val string) {
+	s.Destination = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityWatchLeg) SetOrigin(val string) {
+	s.Origin = val
+}
+
+// SetRealtimeArrivalDateTime sets the value of RealtimeArrivalDateTime.
+func (s *EntityWatchLeg) SetRealtimeArrivalDateTime(val NilString) {
+	s.RealtimeArrivalDateTime = val
+}
+
+// SetRealtimeDepartureDateTime sets the value of RealtimeDepartureDateTime.
+func (s *EntityWatchLeg) SetRealtimeDepartureDateTime(val NilString) {
+	s.RealtimeDepartureDateTime = val
+}
+
+// Ref: #/components/schemas/entity.WatchedFlight
+type EntityWatchedFlight struct {
+	Date          string    `json:"date"`
+	FlightNumber  string    `json:"flightNumber"`
+	OriginAirport NilString `json:"originAirport"`
+}
+
+// GetDate returns the value of Date.
+func (s *EntityWatchedFlight) GetDate() string {
+	return s.Date
+}
+
+// GetFlightNumber returns the value of FlightNumber.
+func (s *EntityWatchedFlight) GetFlightNumber() string {
+	return s.FlightNumber
+}
+
+// GetOriginAirport returns the value of OriginAirport.
+func (s *EntityWatchedFlight) GetOriginAirport() NilString {
+	return s.OriginAirport
+}
+
+// SetDate sets the value of Date.
+func (s *EntityWatchedFlight) SetDate(val string) {
+	s.Date = val
+}
+
+// SetFlightNumber sets the value of FlightNumber.
+func (s *EntityWatchedFlight) SetFlightNumber(val string) {
+	s.FlightNumber = val
+}
+
+// SetOriginAirport sets the value of OriginAirport.
+func (s *EntityWatchedFlight) SetOriginAirport(val NilString) {
+	s.OriginAirport = val
+}
+
+// Ref: #/components/schemas/entity.WatchedTrain
+type EntityWatchedTrain struct {
+	DepartureDate string    `json:"departureDate"`
+	FromStationId string    `json:"fromStationId"`
+	Provider      NilString `json:"provider"`
+	ToStationId   string    `json:"toStationId"`
+	TrainNumbers  []string  `json:"trainNumbers"`
+	ViaStationId  NilString `json:"viaStationId"`
+}
+
+// GetDepartureDate returns the value of DepartureDate.
+func (s *EntityWatchedTrain) GetDepartureDate() string {
+	return s.DepartureDate
+}
+
+// GetFromStationId returns the value of FromStationId.
+func (s *EntityWatchedTrain) GetFromStationId() string {
+	return s.FromStationId
+}
+
+// GetProvider returns the value of Provider.
+func (s *EntityWatchedTrain) GetProvider() NilString {
+	return s.Provider
+}
+
+// GetToStationId returns the value of ToStationId.
+func (s *EntityWatchedTrain) GetToStationId() string {
+	return s.ToStationId
+}
+
+// GetTrainNumbers returns the value of TrainNumbers.
+func (s *EntityWatchedTrain) GetTrainNumbers() []string {
+	return s.TrainNumbers
+}
+
+// GetViaStationId returns the value of ViaStationId.
+func (s *EntityWatchedTrain) GetViaStationId() NilString {
+	return s.ViaStationId
+}
+
+// SetDepartureDate sets the value of DepartureDate.
+func (s *EntityWatchedTrain) SetDepartureDate(val string) {
+	s.DepartureDate = val
+}
+
+// SetFromStationId sets the value of FromStationId.
+func (s *EntityWatchedTrain) SetFromStationId(val string) {
+	s.FromStationId = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *EntityWatchedTrain) SetProvider(val NilString) {
+	s.Provider = val
+}
+
+// SetToStationId sets the value of ToStationId.
+func (s *EntityWatchedTrain) SetToStationId(val string) {
+	s.ToStationId = val
+}
+
+// SetTrainNumbers sets the value of TrainNumbers.
+func (s *EntityWatchedTrain) SetTrainNumbers(val []string) {
+	s.TrainNumbers = val
+}
+
+// SetViaStationId sets the value of ViaStationId.
+func (s *EntityWatchedTrain) SetViaStationId(val NilString) {
+	s.ViaStationId = val
+}
+
+type ListWatchesBadRequest ResponseError
+
+func (*ListWatchesBadRequest) listWatchesRes() {}
+
+type ListWatchesInternalServerError ResponseError
+
+func (*ListWatchesInternalServerError) listWatchesRes() {}
+
+type ListWatchesOKApplicationJSON []EntityWatch
+
+func (*ListWatchesOKApplicationJSON) listWatchesRes() {}
+
+type LookupDirectionsBadRequest ResponseError
+
+func (*LookupDirectionsBadRequest) lookupDirectionsRes() {}
+
+type LookupDirectionsInternalServerError ResponseError
+
+func (*LookupDirectionsInternalServerError) lookupDirectionsRes() {}
+
+type LookupIsochronesBadRequest ResponseError
+
+func (*LookupIsochronesBadRequest) lookupIsochronesRes() {}
+
+type LookupIsochronesInternalServerError ResponseError
+
+func (*LookupIsochronesInternalServerError) lookupIsochronesRes() {}
+
+type LookupIsochronesOK struct{}
+
+func (*LookupIsochronesOK) lookupIsochronesRes() {}
+
+type LookupMatrixBadRequest ResponseError
+
+func (*LookupMatrixBadRequest) lookupMatrixRes() {}
+
+type LookupMatrixInternalServerError ResponseError
+
+func (*LookupMatrixInternalServerError) lookupMatrixRes() {}
+
+type LookupTransitAlertsOKApplicationJSON []EntityServiceAlert
+
+func (*LookupTransitAlertsOKApplicationJSON) lookupTransitAlertsRes() {}
+
+type LookupTransitStopsOKApplicationJSON []EntityTransitStop
+
+func (*LookupTransitStopsOKApplicationJSON) lookupTransitStopsRes() {}
+
+// NewNilFloat64 returns new NilFloat64 with value set to v.
+func NewNilFloat64(v float64) NilFloat64 {
+	return NilFloat64{
+		Value: v,
+	}
+}
+
+// NilFloat64 is nullable float64.
+type NilFloat64 struct {
+	Value float64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilFloat64) SetTo(v float64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilFloat64) SetToNull() {
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilRequestDirectionsUnits returns new NilRequestDirectionsUnits with value set to v.
+func NewNilRequestDirectionsUnits(v RequestDirectionsUnits) NilRequestDirectionsUnits {
+	return NilRequestDirectionsUnits{
+		Value: v,
+	}
+}
+
+// NilRequestDirectionsUnits is nullable RequestDirectionsUnits.
+type NilRequestDirectionsUnits struct {
+	Value RequestDirectionsUnits
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilRequestDirectionsUnits) SetTo(v RequestDirectionsUnits) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilRequestDirectionsUnits) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilRequestDirectionsUnits) SetToNull() {
+	o.Null = true
+	var v RequestDirectionsUnits
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilRequestDirectionsUnits) Get() (v RequestDirectionsUnits, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilRequestDirectionsUnits) Or(d RequestDirectionsUnits) RequestDirectionsUnits {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilRequestPushUrgency returns new NilRequestPushUrgency with value set to v.
+func NewNilRequestPushUrgency(v RequestPushUrgency) NilRequestPushUrgency {
+	return NilRequestPushUrgency{
+		Value: v,
+	}
+}
+
+// NilRequestPushUrgency is nullable RequestPushUrgency.
+type NilRequestPushUrgency struct {
+	Value RequestPushUrgency
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilRequestPushUrgency) SetTo(v RequestPushUrgency) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilRequestPushUrgency) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilRequestPushUrgency) SetToNull() {
+	o.Null = true
+	var v RequestPushUrgency
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilRequestPushUrgency) Get() (v RequestPushUrgency, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilRequestPushUrgency) Or(d RequestPushUrgency) RequestPushUrgency {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityFlight returns new OptEntityFlight with value set to v.
+func NewOptEntityFlight(v EntityFlight) OptEntityFlight {
+	return OptEntityFlight{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityFlight is optional EntityFlight.
+type OptEntityFlight struct {
+	Value EntityFlight
+	Set   bool
+}
+
+// IsSet returns true if OptEntityFlight was set.
+func (o OptEntityFlight) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityFlight) Reset() {
+	var v EntityFlight
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityFlight) SetTo(v EntityFlight) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityFlight) Get() (v EntityFlight, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityFlight) Or(d EntityFlight) EntityFlight {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityGeocodePlace returns new OptEntityGeocodePlace with value set to v.
+func NewOptEntityGeocodePlace(v EntityGeocodePlace) OptEntityGeocodePlace {
+	return OptEntityGeocodePlace{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityGeocodePlace is optional EntityGeocodePlace.
+type OptEntityGeocodePlace struct {
+	Value EntityGeocodePlace
+	Set   bool
+}
+
+// IsSet returns true if OptEntityGeocodePlace was set.
+func (o OptEntityGeocodePlace) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityGeocodePlace) Reset() {
+	var v EntityGeocodePlace
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityGeocodePlace) SetTo(v EntityGeocodePlace) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityGeocodePlace) Get() (v EntityGeocodePlace, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityGeocodePlace) Or(d EntityGeocodePlace) EntityGeocodePlace {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityLocation returns new OptEntityLocation with value set to v.
+func NewOptEntityLocation(v EntityLocation) OptEntityLocation {
+	return OptEntityLocation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityLocation is optional EntityLocation.
+type OptEntityLocation struct {
+	Value EntityLocation
+	Set   bool
+}
+
+// IsSet returns true if OptEntityLocation was set.
+func (o OptEntityLocation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityLocation) Reset() {
+	var v EntityLocation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityLocation) SetTo(v EntityLocation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityLocation) Get() (v EntityLocation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityLocation) Or(d EntityLocation) EntityLocation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityPushSubscription returns new OptEntityPushSubscription with value set to v.
+func NewOptEntityPushSubscription(v EntityPushSubscription) OptEntityPushSubscription {
+	return OptEntityPushSubscription{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityPushSubscription is optional EntityPushSubscription.
+type OptEntityPushSubscription struct {
+	Value EntityPushSubscription
+	Set   bool
+}
+
+// IsSet returns true if OptEntityPushSubscription was set.
+func (o OptEntityPushSubscription) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityPushSubscription) Reset() {
+	var v EntityPushSubscription
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityPushSubscription) SetTo(v EntityPushSubscription) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityPushSubscription) Get() (v EntityPushSubscription, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityPushSubscription) Or(d EntityPushSubscription) EntityPushSubscription {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityTrain returns new OptEntityTrain with value set to v.
+func NewOptEntityTrain(v EntityTrain) OptEntityTrain {
+	return OptEntityTrain{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityTrain is optional EntityTrain.
+type OptEntityTrain struct {
+	Value EntityTrain
+	Set   bool
+}
+
+// IsSet returns true if OptEntityTrain was set.
+func (o OptEntityTrain) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityTrain) Reset() {
+	var v EntityTrain
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityTrain) SetTo(v EntityTrain) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityTrain) Get() (v EntityTrain, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityTrain) Or(d EntityTrain) EntityTrain {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityTrainTrip returns new OptEntityTrainTrip with value set to v.
+func NewOptEntityTrainTrip(v EntityTrainTrip) OptEntityTrainTrip {
+	return OptEntityTrainTrip{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityTrainTrip is optional EntityTrainTrip.
+type OptEntityTrainTrip struct {
+	Value EntityTrainTrip
+	Set   bool
+}
+
+// IsSet returns true if OptEntityTrainTrip was set.
+func (o OptEntityTrainTrip) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityTrainTrip) Reset() {
+	var v EntityTrainTrip
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityTrainTrip) SetTo(v EntityTrainTrip) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityTrainTrip) Get() (v EntityTrainTrip, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityTrainTrip) Or(d EntityTrainTrip) EntityTrainTrip {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityVehiclePosition returns new OptEntityVehiclePosition with value set to v.
+func NewOptEntityVehiclePosition(v EntityVehiclePosition) OptEntityVehiclePosition {
+	return OptEntityVehiclePosition{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityVehiclePosition is optional EntityVehiclePosition.
+type OptEntityVehiclePosition struct {
+	Value EntityVehiclePosition
+	Set   bool
+}
+
+// IsSet returns true if OptEntityVehiclePosition was set.
+func (o OptEntityVehiclePosition) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityVehiclePosition) Reset() {
+	var v EntityVehiclePosition
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityVehiclePosition) SetTo(v EntityVehiclePosition) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityVehiclePosition) Get() (v EntityVehiclePosition, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityVehiclePosition) Or(d EntityVehiclePosition) EntityVehiclePosition {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityWatchedFlight returns new OptEntityWatchedFlight with value set to v.
+func NewOptEntityWatchedFlight(v EntityWatchedFlight) OptEntityWatchedFlight {
+	return OptEntityWatchedFlight{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityWatchedFlight is optional EntityWatchedFlight.
+type OptEntityWatchedFlight struct {
+	Value EntityWatchedFlight
+	Set   bool
+}
+
+// IsSet returns true if OptEntityWatchedFlight was set.
+func (o OptEntityWatchedFlight) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityWatchedFlight) Reset() {
+	var v EntityWatchedFlight
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityWatchedFlight) SetTo(v EntityWatchedFlight) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityWatchedFlight) Get() (v EntityWatchedFlight, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityWatchedFlight) Or(d EntityWatchedFlight) EntityWatchedFlight {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityWatchedTrain returns new OptEntityWatchedTrain with value set to v.
+func NewOptEntityWatchedTrain(v EntityWatchedTrain) OptEntityWatchedTrain {
+	return OptEntityWatchedTrain{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityWatchedTrain is optional EntityWatchedTrain.
+type OptEntityWatchedTrain struct {
+	Value EntityWatchedTrain
+	Set   bool
+}
+
+// IsSet returns true if OptEntityWatchedTrain was set.
+func (o OptEntityWatchedTrain) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityWatchedTrain) Reset() {
+	var v EntityWatchedTrain
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityWatchedTrain) SetTo(v EntityWatchedTrain) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityWatchedTrain) Get() (v EntityWatchedTrain, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityWatchedTrain) Or(d EntityWatchedTrain) EntityWatchedTrain {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRequestFlight returns new OptRequestFlight with value set to v.
+func NewOptRequestFlight(v RequestFlight) OptRequestFlight {
+	return OptRequestFlight{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRequestFlight is optional RequestFlight.
+type OptRequestFlight struct {
+	Value RequestFlight
+	Set   bool
+}
+
+// IsSet returns true if OptRequestFlight was set.
+func (o OptRequestFlight) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestFlight) Reset() {
+	var v RequestFlight
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRequestFlight) SetTo(v RequestFlight) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRequestFlight) Get() (v RequestFlight, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRequestFlight) Or(d RequestFlight) RequestFlight {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRequestFlightLeg returns new OptRequestFlightLeg with value set to v.
+func NewOptRequestFlightLeg(v RequestFlightLeg) OptRequestFlightLeg {
+	return OptRequestFlightLeg{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRequestFlightLeg is optional RequestFlightLeg.
+type OptRequestFlightLeg struct {
+	Value RequestFlightLeg
+	Set   bool
+}
+
+// IsSet returns true if OptRequestFlightLeg was set.
+func (o OptRequestFlightLeg) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestFlightLeg) Reset() {
+	var v RequestFlightLeg
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRequestFlightLeg) SetTo(v RequestFlightLeg) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRequestFlightLeg) Get() (v RequestFlightLeg, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRequestFlightLeg) Or(d RequestFlightLeg) RequestFlightLeg {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRequestTrain returns new OptRequestTrain with value set to v.
+func NewOptRequestTrain(v RequestTrain) OptRequestTrain {
+	return OptRequestTrain{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRequestTrain is optional RequestTrain.
+type OptRequestTrain struct {
+	Value RequestTrain
+	Set   bool
+}
+
+// IsSet returns true if OptRequestTrain was set.
+func (o OptRequestTrain) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestTrain) Reset() {
+	var v RequestTrain
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRequestTrain) SetTo(v RequestTrain) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRequestTrain) Get() (v RequestTrain, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRequestTrain) Or(d RequestTrain) RequestTrain {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRequestTrainTrip returns new OptRequestTrainTrip with value set to v.
+func NewOptRequestTrainTrip(v RequestTrainTrip) OptRequestTrainTrip {
+	return OptRequestTrainTrip{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRequestTrainTrip is optional RequestTrainTrip.
+type OptRequestTrainTrip struct {
+	Value RequestTrainTrip
+	Set   bool
+}
+
+// IsSet returns true if OptRequestTrainTrip was set.
+func (o OptRequestTrainTrip) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestTrainTrip) Reset() {
+	var v RequestTrainTrip
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRequestTrainTrip) SetTo(v RequestTrainTrip) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRequestTrainTrip) Get() (v RequestTrainTrip, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRequestTrainTrip) Or(d RequestTrainTrip) RequestTrainTrip {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRequestTrainTripSection returns new OptRequestTrainTripSection with value set to v.
+func NewOptRequestTrainTripSection(v RequestTrainTripSection) OptRequestTrainTripSection {
+	return OptRequestTrainTripSection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRequestTrainTripSection is optional RequestTrainTripSection.
+type OptRequestTrainTripSection struct {
+	Value RequestTrainTripSection
+	Set   bool
+}
+
+// IsSet returns true if OptRequestTrainTripSection was set.
+func (o OptRequestTrainTripSection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestTrainTripSection) Reset() {
+	var v RequestTrainTripSection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRequestTrainTripSection) SetTo(v RequestTrainTripSection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRequestTrainTripSection) Get() (v RequestTrainTripSection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRequestTrainTripSection) Or(d RequestTrainTripSection) RequestTrainTripSection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptResponseError returns new OptResponseError with value set to v.
+func NewOptResponseError(v ResponseError) OptResponseError {
+	return OptResponseError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptResponseError is optional ResponseError.
+type OptResponseError struct {
+	Value ResponseError
+	Set   bool
+}
+
+// IsSet returns true if OptResponseError was set.
+func (o OptResponseError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptResponseError) Reset() {
+	var v ResponseError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptResponseError) SetTo(v ResponseError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptResponseError) Get() (v ResponseError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptResponseError) Or(d ResponseError) ResponseError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+type PlanRoadTripBadRequest ResponseError
+
+func (*PlanRoadTripBadRequest) planRoadTripRes() {}
+
+type PlanRoadTripInternalServerError ResponseError
+
+func (*PlanRoadTripInternalServerError) planRoadTripRes() {}
+
+type PostBatchBadRequest ResponseError
+
+func (*PostBatchBadRequest) postBatchRes() {}
+
+type PostBatchInternalServerError ResponseError
+
+func (*PostBatchInternalServerError) postBatchRes() {}
+
+type PostBatchOKApplicationJSON []ResponseBatchResult
+
+func (*PostBatchOKApplicationJSON) postBatchRes() {}
+
+type PostFlightBadGateway ResponseError
+
+func (*PostFlightBadGateway) postFlightRes() {}
+
+type PostFlightBadRequest ResponseError
+
+func (*PostFlightBadRequest) postFlightRes() {}
+
+type PostFlightGatewayTimeout ResponseError
+
+func (*PostFlightGatewayTimeout) postFlightRes() {}
+
+type PostFlightInternalServerError ResponseError
+
+func (*PostFlightInternalServerError) postFlightRes() {}
+
+type PostFlightNotFound ResponseError
+
+func (*PostFlightNotFound) postFlightRes() {}
+
+type PostFlightServiceUnavailable ResponseError
+
+func (*PostFlightServiceUnavailable) postFlightRes() {}
+
+type PostTrainJourneyBadGateway ResponseError
+
+func (*PostTrainJourneyBadGateway) postTrainJourneyRes() {}
+
+type PostTrainJourneyBadRequest ResponseError
+
+func (*PostTrainJourneyBadRequest) postTrainJourneyRes() {}
+
+type PostTrainJourneyGatewayTimeout ResponseError
+
+func (*PostTrainJourneyGatewayTimeout) postTrainJourneyRes() {}
+
+type PostTrainJourneyInternalServerError ResponseError
+
+func (*PostTrainJourneyInternalServerError) postTrainJourneyRes() {}
+
+type PostTrainJourneyNotFound ResponseError
+
+func (*PostTrainJourneyNotFound) postTrainJourneyRes() {}
+
+type PostTrainJourneyServiceUnavailable ResponseError
+
+func (*PostTrainJourneyServiceUnavailable) postTrainJourneyRes() {}
+
+type PostTrainTripBadGateway ResponseError
+
+func (*PostTrainTripBadGateway) postTrainTripRes() {}
+
+type PostTrainTripBadRequest ResponseError
+
+func (*PostTrainTripBadRequest) postTrainTripRes() {}
+
+type PostTrainTripGatewayTimeout ResponseError
+
+func (*PostTrainTripGatewayTimeout) postTrainTripRes() {}
+
+type PostTrainTripInternalServerError ResponseError
+
+func (*PostTrainTripInternalServerError) postTrainTripRes() {}
+
+type PostTrainTripNotFound ResponseError
+
+func (*PostTrainTripNotFound) postTrainTripRes() {}
+
+type PostTrainTripSectionBadGateway ResponseError
+
+func (*PostTrainTripSectionBadGateway) postTrainTripSectionRes() {}
+
+type PostTrainTripSectionBadRequest ResponseError
+
+func (*PostTrainTripSectionBadRequest) postTrainTripSectionRes() {}
+
+type PostTrainTripSectionGatewayTimeout ResponseError
+
+func (*PostTrainTripSectionGatewayTimeout) postTrainTripSectionRes() {}
+
+type PostTrainTripSectionInternalServerError ResponseError
+
+func (*PostTrainTripSectionInternalServerError) postTrainTripSectionRes() {}
+
+type PostTrainTripSectionNotFound ResponseError
+
+func (*PostTrainTripSectionNotFound) postTrainTripSectionRes() {}
+
+type PostTrainTripSectionServiceUnavailable ResponseError
+
+func (*PostTrainTripSectionServiceUnavailable) postTrainTripSectionRes() {}
+
+type PostTrainTripServiceUnavailable ResponseError
+
+func (*PostTrainTripServiceUnavailable) postTrainTripRes() {}
+
+type PostTransitBadRequest ResponseError
+
+func (*PostTransitBadRequest) postTransitRes() {}
+
+type PostTransitInternalServerError ResponseError
+
+func (*PostTransitInternalServerError) postTransitRes() {}
+
+type PostTransitNotFound ResponseError
+
+func (*PostTransitNotFound) postTransitRes() {}
+
+type PostTransitTripsBadRequest ResponseError
+
+func (*PostTransitTripsBadRequest) postTransitTripsRes() {}
+
+type PostTransitTripsInternalServerError ResponseError
+
+func (*PostTransitTripsInternalServerError) postTransitTripsRes() {}
+
+type PostTransitTripsOKApplicationJSON []EntityTransitLeg
+
+func (*PostTransitTripsOKApplicationJSON) postTransitTripsRes() {}
+
+// Ref: #/components/schemas/request.Autocomplete
+type RequestAutocomplete struct {
+	Countries []string          `json:"countries"`
+	Focus     OptEntityLocation `json:"focus"`
+	Layers    []string          `json:"layers"`
+	Size      NilInt            `json:"size"`
+	Text      string            `json:"text"`
+}
+
+// GetCountries returns the value of Countries.
+func (s *RequestAutocomplete) GetCountries() []string {
+	return s.Countries
+}
+
+// GetFocus returns the value of Focus.
+func (s *RequestAutocomplete) GetFocus() OptEntityLocation {
+	return s.Focus
+}
+
+// GetLayers returns the value of Layers.
+func (s *RequestAutocomplete) GetLayers() []string {
+	return s.Layers
+}
+
+// GetSize returns the value of Size.
+func (s *RequestAutocomplete) GetSize() NilInt {
+	return s.Size
+}
+
+// GetText returns the value of Text.
+func (s *RequestAutocomplete) GetText() string {
+	return s.Text
+}
+
+// SetCountries sets the value of Countries.
+func (s *RequestAutocomplete) SetCountries(val []string) {
+	s.Countries = val
+}
+
+// SetFocus sets the value of Focus.
+func (s *RequestAutocomplete) SetFocus(val OptEntityLocation) {
+	s.Focus = val
+}
+
+// SetLayers sets the value of Layers.
+func (s *RequestAutocomplete) SetLayers(val []string) {
+	s.Layers = val
+}
+
+// SetSize sets the value of Size.
+func (s *RequestAutocomplete) SetSize(val NilInt) {
+	s.Size = val
+}
+
+// SetText sets the value of Text.
+func (s *RequestAutocomplete) SetText(val string) {
+	s.Text = val
+}
+
+// Ref: #/components/schemas/request.Batch
+type RequestBatch struct {
+	Items []RequestBatchItem `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *RequestBatch) GetItems() []RequestBatchItem {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *RequestBatch) SetItems(val []RequestBatchItem) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/request.BatchItem
+type RequestBatchItem struct {
+	Flight           OptRequestFlight           `json:"flight"`
+	ID               string                     `json:"id"`
+	Train            OptRequestTrain            `json:"train"`
+	TrainTrip        OptRequestTrainTrip        `json:"trainTrip"`
+	TrainTripSection OptRequestTrainTripSection `json:"trainTripSection"`
+}
+
+// GetFlight returns the value of Flight.
+func (s *RequestBatchItem) GetFlight() OptRequestFlight {
+	return s.Flight
+}
+
+// GetID returns the value of ID.
+func (s *RequestBatchItem) GetID() string {
+	return s.ID
+}
+
+// GetTrain returns the value of Train.
+func (s *RequestBatchItem) GetTrain() OptRequestTrain {
+	return s.Train
+}
+
+// GetTrainTrip returns the value of TrainTrip.
+func (s *RequestBatchItem) GetTrainTrip() OptRequestTrainTrip {
+	return s.TrainTrip
+}
+
+// GetTrainTripSection returns the value of TrainTripSection.
+func (s *RequestBatchItem) GetTrainTripSection() OptRequestTrainTripSection {
+	return s.TrainTripSection
+}
+
+// SetFlight sets the value of Flight.
+func (s *RequestBatchItem) SetFlight(val OptRequestFlight) {
+	s.Flight = val
+}
+
+// SetID sets the value of ID.
+func (s *RequestBatchItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetTrain sets the value of Train.
+func (s *RequestBatchItem) SetTrain(val OptRequestTrain) {
+	s.Train = val
+}
+
+// SetTrainTrip sets the value of TrainTrip.
+func (s *RequestBatchItem) SetTrainTrip(val OptRequestTrainTrip) {
+	s.TrainTrip = val
+}
+
+// SetTrainTripSection sets the value of TrainTripSection.
+func (s *RequestBatchItem) SetTrainTripSection(val OptRequestTrainTripSection) {
+	s.TrainTripSection = val
+}
+
+// Ref: #/components/schemas/request.Directions
+type RequestDirections struct {
+	Alternatives       NilInt                    `json:"alternatives"`
+	Avoid              []string                  `json:"avoid"`
+	End                EntityLocation            `json:"end"`
+	Language           NilString                 `json:"language"`
+	Start              EntityLocation            `json:"start"`
+	TransportationType EntityTransportationType  `json:"transportationType"`
+	Units              NilRequestDirectionsUnits `json:"units"`
+	Waypoints          []EntityLocation          `json:"waypoints"`
+}
+
+// GetAlternatives returns the value of Alternatives.
+func (s *RequestDirections) GetAlternatives() NilInt {
+	return s.Alternatives
+}
+
+// GetAvoid returns the value of Avoid.
+func (s *RequestDirections) GetAvoid() []string {
+	return s.Avoid
+}
+
+// GetEnd returns the value of End.
+func (s *RequestDirections) GetEnd() EntityLocation {
+	return s.End
+}
+
+// GetLanguage returns the value of Language.
+func (s *RequestDirections) GetLanguage() NilString {
+	return s.Language
+}
+
+// GetStart returns the value of Start.
+func (s *RequestDirections) GetStart() EntityLocation {
+	return s.Start
+}
+
+// GetTransportationType returns the value of TransportationType.
+func (s *RequestDirections) GetTransportationType() EntityTransportationType {
+	return s.TransportationType
+}
+
+// GetUnits returns the value of Units.
+func (s *RequestDirections) GetUnits() NilRequestDirectionsUnits {
+	return s.Units
+}
+
+// GetWaypoints returns the value of Waypoints.
+func (s *RequestDirections) GetWaypoints() []EntityLocation {
+	return s.Waypoints
+}
+
+// SetAlternatives sets the value of Alternatives.
+func (s *RequestDirections) SetAlternatives(val NilInt) {
+	s.Alternatives = val
+}
+
+// SetAvoid sets the value of Avoid.
+func (s *RequestDirections) SetAvoid(val []string) {
+	s.Avoid = val
+}
+
+// SetEnd sets the value of End.
+func (s *RequestDirections) SetEnd(val EntityLocation) {
+	s.End = val
+}
+
+// SetLanguage sets the value of Language.
+func (s *RequestDirections) SetLanguage(val NilString) {
+	s.Language = val
+}
+
+// SetStart sets the value of Start.
+func (s *RequestDirections) SetStart(val EntityLocation) {
+	s.Start = val
+}
+
+// SetTransportationType sets the value of TransportationType.
+func (s *RequestDirections) SetTransportationType(val EntityTransportationType) {
+	s.TransportationType = val
+}
+
+// SetUnits sets the value of Units.
+func (s *RequestDirections) SetUnits(val NilRequestDirectionsUnits) {
+	s.Units = val
+}
+
+// SetWaypoints sets the value of Waypoints.
+func (s *RequestDirections) SetWaypoints(val []EntityLocation) {
+	s.Waypoints = val
+}
+
+type RequestDirectionsUnits string
+
+const (
+	RequestDirectionsUnitsM  RequestDirectionsUnits = "m"
+	RequestDirectionsUnitsKm RequestDirectionsUnits = "km"
+	RequestDirectionsUnitsMi RequestDirectionsUnits = "mi"
+)
+
+// AllValues returns all RequestDirectionsUnits values.
+func (RequestDirectionsUnits) AllValues() []RequestDirectionsUnits {
+	return []RequestDirectionsUnits{
+		RequestDirectionsUnitsM,
+		RequestDirectionsUnitsKm,
+		RequestDirectionsUnitsMi,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RequestDirectionsUnits) MarshalText() ([]byte, error) {
+	switch s {
+	case RequestDirectionsUnitsM:
+		return []byte(s), nil
+	case RequestDirectionsUnitsKm:
+		return []byte(s), nil
+	case RequestDirectionsUnitsMi:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RequestDirectionsUnits) UnmarshalText(data []byte) error {
+	switch RequestDirectionsUnits(data) {
+	case RequestDirectionsUnitsM:
+		*s = RequestDirectionsUnitsM
+		return nil
+	case RequestDirectionsUnitsKm:
+		*s = RequestDirectionsUnitsKm
+		return nil
+	case RequestDirectionsUnitsMi:
+		*s = RequestDirectionsUnitsMi
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/request.Flight
+type RequestFlight struct {
+	Legs []RequestFlightLeg `json:"legs"`
+}
+
+// GetLegs returns the value of Legs.
+func (s *RequestFlight) GetLegs() []RequestFlightLeg {
+	return s.Legs
+}
+
+// SetLegs sets the value of Legs.
+func (s *RequestFlight) SetLegs(val []RequestFlightLeg) {
+	s.Legs = val
+}
+
+// Ref: #/components/schemas/request.FlightLeg
+type RequestFlightLeg struct {
+	Date          string    `json:"date"`
+	FlightNumber  string    `json:"flightNumber"`
+	OriginAirport NilString `json:"originAirport"`
+}
+
+// GetDate returns the value of Date.
+func (s *RequestFlightLeg) GetDate() string {
+	return s.Date
+}
+
+// GetFlightNumber returns the value of FlightNumber.
+func (s *RequestFlightLeg) GetFlightNumber() string {
+	return s.FlightNumber
+}
+
+// GetOriginAirport returns the value of OriginAirport.
+func (s *RequestFlightLeg) GetOriginAirport() NilString {
+	return s.OriginAirport
+}
+
+// SetDate sets the value of Date.
+func (s *RequestFlightLeg) SetDate(val string) {
+	s.Date = val
+}
+
+// SetFlightNumber sets the value of FlightNumber.
+func (s *RequestFlightLeg) SetFlightNumber(val string) {
+	s.FlightNumber = val
+}
+
+// SetOriginAirport sets the value of OriginAirport.
+func (s *RequestFlightLeg) SetOriginAirport(val NilString) {
+	s.OriginAirport = val
+}
+
+// Ref: #/components/schemas/request.Isochrones
+type RequestIsochrones struct {
+	Locations          []EntityLocation           `json:"locations"`
+	RangeType          RequestIsochronesRangeType `json:"rangeType"`
+	Ranges             []int                      `json:"ranges"`
+	TransportationType string                     `json:"transportationType"`
+}
+
+// GetLocations returns the value of Locations.
+func (s *RequestIsochrones) GetLocations() []EntityLocation {
+	return s.Locations
+}
+
+// GetRangeType returns the value of RangeType.
+func (s *RequestIsochrones) GetRangeType() RequestIsochronesRangeType {
+	return s.RangeType
+}
+
+// GetRanges returns the value of Ranges.
+func (s *RequestIsochrones) GetRanges() []int {
+	return s.Ranges
+}
+
+// GetTransportationType returns the value of TransportationType.
+func (s *RequestIsochrones) GetTransportationType() string {
+	return s.TransportationType
+}
+
+// SetLocations sets the value of Locations.
+func (s *RequestIsochrones) SetLocations(val []EntityLocation) {
+	s.Locations = val
+}
+
+// SetRangeType sets the value of RangeType.
+func (s *RequestIsochrones) SetRangeType(val RequestIsochronesRangeType) {
+	s.RangeType = val
+}
+
+// SetRanges sets the value of Ranges.
+func (s *RequestIsochrones) SetRanges(val []int) {
+	s.Ranges = val
+}
+
+// SetTransportationType sets the value of TransportationType.
+func (s *RequestIsochrones) SetTransportationType(val string) {
+	s.TransportationType = val
+}
+
+type RequestIsochronesRangeType string
+
+const (
+	RequestIsochronesRangeTypeTime     RequestIsochronesRangeType = "time"
+	RequestIsochronesRangeTypeDistance RequestIsochronesRangeType = "distance"
+)
+
+// AllValues returns all RequestIsochronesRangeType values.
+func (RequestIsochronesRangeType) AllValues() []RequestIsochronesRangeType {
+	return []RequestIsochronesRangeType{
+		RequestIsochronesRangeTypeTime,
+		RequestIsochronesRangeTypeDistance,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RequestIsochronesRangeType) MarshalText() ([]byte, error) {
+	switch s {
+	case RequestIsochronesRangeTypeTime:
+		return []byte(s), nil
+	case RequestIsochronesRangeTypeDistance:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RequestIsochronesRangeType) UnmarshalText(data []byte) error {
+	switch RequestIsochronesRangeType(data) {
+	case RequestIsochronesRangeTypeTime:
+		*s = RequestIsochronesRangeTypeTime
+		return nil
+	case RequestIsochronesRangeTypeDistance:
+		*s = RequestIsochronesRangeTypeDistance
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/request.Matrix
+type RequestMatrix struct {
+	Locations          []EntityLocation `json:"locations"`
+	Optimize           bool             `json:"optimize"`
+	TransportationType string           `json:"transportationType"`
+}
+
+// GetLocations returns the value of Locations.
+func (s *RequestMatrix) GetLocations() []EntityLocation {
+	return s.Locations
+}
+
+// GetOptimize returns the value of Optimize.
+func (s *RequestMatrix) GetOptimize() bool {
+	return s.Optimize
+}
+
+// GetTransportationType returns the value of TransportationType.
+func (s *RequestMatrix) GetTransportationType() string {
+	return s.TransportationType
+}
+
+// SetLocations sets the value of Locations.
+func (s *RequestMatrix) SetLocations(val []EntityLocation) {
+	s.Locations = val
+}
+
+// SetOptimize sets the value of Optimize.
+func (s *RequestMatrix) SetOptimize(val bool) {
+	s.Optimize = val
+}
+
+// SetTransportationType sets the value of TransportationType.
+func (s *RequestMatrix) SetTransportationType(val string) {
+	s.TransportationType = val
+}
+
+// Ref: #/components/schemas/request.Pois
+type RequestPois struct {
+	BoundingBox []float64      `json:"boundingBox"`
+	Categories  []string       `json:"categories"`
+	Location    EntityLocation `json:"location"`
+	Radius      NilInt         `json:"radius"`
+	Size        NilInt         `json:"size"`
+}
+
+// GetBoundingBox returns the value of BoundingBox.
+func (s *RequestPois) GetBoundingBox() []float64 {
+	return s.BoundingBox
+}
+
+// GetCategories returns the value of Categories.
+func (s *RequestPois) GetCategories() []string {
+	return s.Categories
+}
+
+// GetLocation returns the value of Location.
+func (s *RequestPois) GetLocation() EntityLocation {
+	return s.Location
+}
+
+// GetRadius returns the value of Radius.
+func (s *RequestPois) GetRadius() NilInt {
+	return s.Radius
+}
+
+// GetSize returns the value of Size.
+func (s *RequestPois) GetSize() NilInt {
+	return s.Size
+}
+
+// SetBoundingBox sets the value of BoundingBox.
+func (s *RequestPois) SetBoundingBox(val []float64) {
+	s.BoundingBox = val
+}
+
+// SetCategories sets the value of Categories.
+func (s *RequestPois) SetCategories(val []string) {
+	s.Categories = val
+}
+
+// SetLocation sets the value of Location.
+func (s *RequestPois) SetLocation(val EntityLocation) {
+	s.Location = val
+}
+
+// SetRadius sets the value of Radius.
+func (s *RequestPois) SetRadius(val NilInt) {
+	s.Radius = val
+}
+
+// SetSize sets the value of Size.
+func (s *RequestPois) SetSize(val NilInt) {
+	s.Size = val
+}
+
+// Ref: #/components/schemas/request.Push
+type RequestPush struct {
+	// Notification is sent as is, so its JSON must fit into a push message.
+	Notification RequestPushNotification `json:"notification"`
+	Subscription EntityPushSubscription  `json:"subscription"`
+	Topic        NilString               `json:"topic"`
+	TTL          NilInt                  `json:"ttl"`
+	Urgency      NilRequestPushUrgency   `json:"urgency"`
+}
+
+// GetNotification returns the value of Notification.
+func (s *RequestPush) GetNotification() RequestPushNotification {
+	return s.Notification
+}
+
+// GetSubscription returns the value of Subscription.
+func (s *RequestPush) GetSubscription() EntityPushSubscription {
+	return s.Subscription
+}
+
+// GetTopic returns the value of Topic.
+func (s *RequestPush) GetTopic() NilString {
+	return s.Topic
+}
+
+// GetTTL returns the value of TTL.
+func (s *RequestPush) GetTTL() NilInt {
+	return s.TTL
+}
+
+// GetUrgency returns the value of Urgency.
+func (s *RequestPush) GetUrgency() NilRequestPushUrgency {
+	return s.Urgency
+}
+
+// SetNotification sets the value of Notification.
+func (s *RequestPush) SetNotification(val RequestPushNotification) {
+	s.Notification = val
+}
+
+// SetSubscription sets the value of Subscription.
+func (s *RequestPush) SetSubscription(val EntityPushSubscription) {
+	s.Subscription = val
+}
+
+// SetTopic sets the value of Topic.
+func (s *RequestPush) SetTopic(val NilString) {
+	s.Topic = val
+}
+
+// SetTTL sets the value of TTL.
+func (s *RequestPush) SetTTL(val NilInt) {
+	s.TTL = val
+}
+
+// SetUrgency sets the value of Urgency.
+func (s *RequestPush) SetUrgency(val NilRequestPushUrgency) {
+	s.Urgency = val
+}
+
+// Notification is sent as is, so its JSON must fit into a push message.
+type RequestPushNotification struct{}
+
+type RequestPushUrgency string
+
+const (
+	RequestPushUrgencyVeryLow RequestPushUrgency = "very-low"
+	RequestPushUrgencyLow     RequestPushUrgency = "low"
+	RequestPushUrgencyNormal  RequestPushUrgency = "normal"
+	RequestPushUrgencyHigh    RequestPushUrgency = "high"
+)
+
+// AllValues returns all RequestPushUrgency values.
+func (RequestPushUrgency) AllValues() []RequestPushUrgency {
+	return []RequestPushUrgency{
+		RequestPushUrgencyVeryLow,
+		RequestPushUrgencyLow,
+		RequestPushUrgencyNormal,
+		RequestPushUrgencyHigh,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RequestPushUrgency) MarshalText() ([]byte, error) {
+	switch s {
+	case RequestPushUrgencyVeryLow:
+		return []byte(s), nil
+	case RequestPushUrgencyLow:
+		return []byte(s), nil
+	case RequestPushUrgencyNormal:
+		return []byte(s), nil
+	case RequestPushUrgencyHigh:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RequestPushUrgency) UnmarshalText(data []byte) error {
+	switch RequestPushUrgency(data) {
+	case RequestPushUrgencyVeryLow:
+		*s = RequestPushUrgencyVeryLow
+		return nil
+	case RequestPushUrgencyLow:
+		*s = RequestPushUrgencyLow
+		return nil
+	case RequestPushUrgencyNormal:
+		*s = RequestPushUrgencyNormal
+		return nil
+	case RequestPushUrgencyHigh:
+		*s = RequestPushUrgencyHigh
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/request.RoadTrip
+type RequestRoadTrip struct {
+	Avoid                  []string         `json:"avoid"`
+	End                    EntityLocation   `json:"end"`
+	Language               NilString        `json:"language"`
+	MaxDailyDrivingMinutes int              `json:"maxDailyDrivingMinutes"`
+	Start                  EntityLocation   `json:"start"`
+	TransportationType     string           `json:"transportationType"`
+	Waypoints              []EntityLocation `json:"waypoints"`
+}
+
+// GetAvoid returns the value of Avoid.
+func (s *RequestRoadTrip) GetAvoid() []string {
+	return s.Avoid
+}
+
+// GetEnd returns the value of End.
+func (s *RequestRoadTrip) GetEnd() EntityLocation {
+	return s.End
+}
+
+// GetLanguage returns the value of Language.
+func (s *RequestRoadTrip) GetLanguage() NilString {
+	return s.Language
+}
+
+// GetMaxDailyDrivingMinutes returns the value of MaxDailyDrivingMinutes.
+func (s *RequestRoadTrip) GetMaxDailyDrivingMinutes() int {
+	return s.MaxDailyDrivingMinutes
+}
+
+// GetStart returns the value of Start.
+func (s *RequestRoadTrip) GetStart() EntityLocation {
+	return s.Start
+}
+
+// GetTransportationType returns the value of TransportationType.
+func (s *RequestRoadTrip) GetTransportationType() string {
+	return s.TransportationType
+}
+
+// GetWaypoints returns the value of Waypoints.
+func (s *RequestRoadTrip) GetWaypoints() []EntityLocation {
+	return s.Waypoints
+}
+
+// SetAvoid sets the value of Avoid.
+func (s *RequestRoadTrip) SetAvoid(val []string) {
+	s.Avoid = val
+}
+
+// SetEnd sets the value of End.
+func (s *RequestRoadTrip) SetEnd(val EntityLocation) {
+	s.End = val
+}
+
+// SetLanguage sets the value of Language.
+func (s *RequestRoadTrip) SetLanguage(val NilString) {
+	s.Language = val
+}
+
+// SetMaxDailyDrivingMinutes sets the value of MaxDailyDrivingMinutes.
+func (s *RequestRoadTrip) SetMaxDailyDrivingMinutes(val int) {
+	s.MaxDailyDrivingMinutes = val
+}
+
+// SetStart sets the value of Start.
+func (s *RequestRoadTrip) SetStart(val EntityLocation) {
+	s.Start = val
+}
+
+// SetTransportationType sets the value of TransportationType.
+func (s *RequestRoadTrip) SetTransportationType(val string) {
+	s.TransportationType = val
+}
+
+// SetWaypoints sets the value of Waypoints.
+func (s *RequestRoadTrip) SetWaypoints(val []EntityLocation) {
+	s.Waypoints = val
+}
+
+// Ref: #/components/schemas/request.Train
+type RequestTrain struct {
+	DepartureDate string    `json:"departureDate"`
+	FromStationId string    `json:"fromStationId"`
+	Provider      NilString `json:"provider"`
+	ToStationId   string    `json:"toStationId"`
+	TrainNumbers  []string  `json:"trainNumbers"`
+	ViaStationId  NilString `json:"viaStationId"`
+}
+
+// GetDepartureDate returns the value of DepartureDate.
+func (s *RequestTrain) GetDepartureDate() string {
+	return s.DepartureDate
+}
+
+// GetFromStationId returns the value of FromStationId.
+func (s *RequestTrain) GetFromStationId() string {
+	return s.FromStationId
+}
+
+// GetProvider returns the value of Provider.
+func (s *RequestTrain) GetProvider() NilString {
+	return s.Provider
+}
+
+// GetToStationId returns the value of ToStationId.
+func (s *RequestTrain) GetToStationId() string {
+	return s.ToStationId
+}
+
+// GetTrainNumbers returns the value of TrainNumbers.
+func (s *RequestTrain) GetTrainNumbers() []string {
+	return s.TrainNumbers
+}
+
+// GetViaStationId returns the value of ViaStationId.
+func (s *RequestTrain) GetViaStationId() NilString {
+	return s.ViaStationId
+}
+
+// SetDepartureDate sets the value of DepartureDate.
+func (s *RequestTrain) SetDepartureDate(val string) {
+	s.DepartureDate = val
+}
+
+// SetFromStationId sets the value of FromStationId.
+func (s *RequestTrain) SetFromStationId(val string) {
+	s.FromStationId = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *RequestTrain) SetProvider(val NilString) {
+	s.Provider = val
+}
+
+// SetToStationId sets the value of ToStationId.
+func (s *RequestTrain) SetToStationId(val string) {
+	s.ToStationId = val
+}
+
+// SetTrainNumbers sets the value of TrainNumbers.
+func (s *RequestTrain) SetTrainNumbers(val []string) {
+	s.TrainNumbers = val
+}
+
+// SetViaStationId sets the value of ViaStationId.
+func (s *RequestTrain) SetViaStationId(val NilString) {
+	s.ViaStationId = val
+}
+
+// Ref: #/components/schemas/request.TrainTrip
+type RequestTrainTrip struct {
+	DepartureDate string `json:"departureDate"`
+	TrainNumber   string `json:"trainNumber"`
+}
+
+// GetDepartureDate returns the value of DepartureDate.
+func (s *RequestTrainTrip) GetDepartureDate() string {
+	return s.DepartureDate
+}
+
+// GetTrainNumber returns the value of TrainNumber.
+func (s *RequestTrainTrip) GetTrainNumber() string {
+	return s.TrainNumber
+}
+
+// SetDepartureDate sets the value of DepartureDate.
+func (s *RequestTrainTrip) SetDepartureDate(val string) {
+	s.DepartureDate = val
+}
+
+// SetTrainNumber sets the value of TrainNumber.
+func (s *RequestTrainTrip) SetTrainNumber(val string) {
+	s.TrainNumber = val
+}
+
+// Ref: #/components/schemas/request.TrainTripSection
+type RequestTrainTripSection struct {
+	DepartureDate string `json:"departureDate"`
+	FromStationId string `json:"fromStationId"`
+	ToStationId   string `json:"toStationId"`
+	TrainNumber   string `json:"trainNumber"`
+}
+
+// GetDepartureDate returns the value of DepartureDate.
+func (s *RequestTrainTripSection) GetDepartureDate() string {
+	return s.DepartureDate
+}
+
+// GetFromStationId returns the value of FromStationId.
+func (s *RequestTrainTripSection) GetFromStationId() string {
+	return s.FromStationId
+}
+
+// GetToStationId returns the value of ToStationId.
+func (s *RequestTrainTripSection) GetToStationId() string {
+	return s.ToStationId
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetTrainNumber returns the value of TrainNumber.
+func (s *RequestTrainTripSection) GetTrainNumber() string {
+	return s.TrainNumber
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetDepartureDate sets the value of DepartureDate.
+func (s *RequestTrainTripSection) SetDepartureDate(val string) {
+	s.DepartureDate = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetFromStationId sets the value of FromStationId.
+func (s *RequestTrainTripSection) SetFromStationId(val string) {
+	s.FromStationId = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// SetToStationId sets the value of ToStationId.
+func (s *RequestTrainTripSection) SetToStationId(val string) {
+	s.ToStationId = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// SetTrainNumber sets the value of TrainNumber.
+func (s *RequestTrainTripSection) SetTrainNumber(val string) {
+	s.TrainNumber = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// Ref: #/components/schemas/request.Transit
+type RequestTransit struct {
+	Legs []RequestTransitLeg `json:"legs"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetLegs returns the value of Legs.
+func (s *RequestTransit) GetLegs() []RequestTransitLeg {
+	return s.Legs
 }
 
-// Ref: #/components/schemas/request.Directions
-type RequestDirections struct {
-	End                EntityLocation           `json:"end"`
-	Start              EntityLocation           `json:"start"`
-	TransportationType EntityTransportationType `json:"transportationType"`
+// SetLegs sets the value of Legs.
+func (s *RequestTransit) SetLegs(val []RequestTransitLeg) {
+	s.Legs = val
 }
 
-// GetEnd returns the value of End.
-func (s *RequestDirections) GetEnd() EntityLocation {
-	return s.End
+// Ref: #/components/schemas/request.TransitLeg
+type RequestTransitLeg struct {
+	Date       string `json:"date"`
+	Feed       string `json:"feed"`
+	FromStopId string `json:"fromStopId"`
+	ToStopId   string `json:"toStopId"`
+	TripId     string `json:"tripId"`
 }
 
-// GetStart returns the value of Start.
-func (s *RequestDirections) GetStart() EntityLocation {
-	return s.Start
+// GetDate returns the value of Date.
+func (s *RequestTransitLeg) GetDate() string {
+	return s.Date
 }
 
-// GetTransportationType returns the value of TransportationType.
-func (s *RequestDirections) GetTransportationType() EntityTransportationType {
-	return s.TransportationType
+// GetFeed returns the value of Feed.
+func (s *RequestTransitLeg) GetFeed() string {
+	return s.Feed
 }
 
-// SetEnd sets the value of End.
-func (s *RequestDirections) SetEnd(val EntityLocation) {
-	s.End = val
+// GetFromStopId returns the value of FromStopId.
+func (s *RequestTransitLeg) GetFromStopId() string {
+	return s.FromStopId
 }
 
-// SetStart sets the value of Start.
-func (s *RequestDirections) SetStart(val EntityLocation) {
-	s.Start = val
+// GetToStopId returns the value of ToStopId.
+func (s *RequestTransitLeg) GetToStopId() string {
+	return s.ToStopId
 }
 
-// SetTransportationType sets the value of TransportationType.
-func (s *RequestDirections) SetTransportationType(val EntityTransportationType) {
-	s.TransportationType = val
+// GetTripId returns the value of TripId.
+func (s *RequestTransitLeg) GetTripId() string {
+	return s.TripId
 }
 
-// Ref: #/components/schemas/request.Flight
-type RequestFlight struct {
-	Legs []RequestFlightLeg `json:"legs"`
+// SetDate sets the value of Date.
+func (s *RequestTransitLeg) SetDate(val string) {
+	s.Date = val
 }
 
-// GetLegs returns the value of Legs.
-func (s *RequestFlight) GetLegs() []RequestFlightLeg {
-	return s.Legs
+// SetFeed sets the value of Feed.
+func (s *RequestTransitLeg) SetFeed(val string) {
+	s.Feed = val
 }
 
-// SetLegs sets the value of Legs.
-func (s *RequestFlight) SetLegs(val []RequestFlightLeg) {
-	s.Legs = val
+// SetFromStopId sets the value of FromStopId.
+func (s *RequestTransitLeg) SetFromStopId(val string) {
+	s.FromStopId = val
 }
 
-// Ref: #/components/schemas/request.FlightLeg
-type RequestFlightLeg struct {
-	Date          string    `json:"date"`
-	FlightNumber  string    `json:"flightNumber"`
-	OriginAirport NilString `json:"originAirport"`
+// SetToStopId sets the value of ToStopId.
+func (s *RequestTransitLeg) SetToStopId(val string) {
+	s.ToStopId = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *RequestTransitLeg) SetTripId(val string) {
+	s.TripId = val
+}
+
+// Ref: #/components/schemas/request.TransitTrips
+type RequestTransitTrips struct {
+	Date           string    `json:"date"`
+	Feed           NilString `json:"feed"`
+	FromStopId     NilString `json:"fromStopId"`
+	RouteShortName string    `json:"routeShortName"`
+	ToStopId       NilString `json:"toStopId"`
 }
 
 // GetDate returns the value of Date.
-func (s *RequestFlightLeg) GetDate() string {
+func (s *RequestTransitTrips) GetDate() string {
 	return s.Date
 }
 
-// GetFlightNumber returns the value of FlightNumber.
-func (s *RequestFlightLeg) GetFlightNumber() string {
-	return s.FlightNumber
+// GetFeed returns the value of Feed.
+func (s *RequestTransitTrips) GetFeed() NilString {
+	return s.Feed
 }
 
-// GetOriginAirport returns the value of OriginAirport.
-func (s *RequestFlightLeg) GetOriginAirport() NilString {
-	return s.OriginAirport
+// GetFromStopId returns the value of FromStopId.
+func (s *RequestTransitTrips) GetFromStopId() NilString {
+	return s.FromStopId
+}
+
+// GetRouteShortName returns the value of RouteShortName.
+func (s *RequestTransitTrips) GetRouteShortName() string {
+	return s.RouteShortName
+}
+
+// GetToStopId returns the value of ToStopId.
+func (s *RequestTransitTrips) GetToStopId() NilString {
+	return s.ToStopId
 }
 
 // SetDate sets the value of Date.
-func (s *RequestFlightLeg) SetDate(val string) {
+func (s *RequestTransitTrips) SetDate(val string) {
 	s.Date = val
 }
 
-// SetFlightNumber sets the value of FlightNumber.
-func (s *RequestFlightLeg) SetFlightNumber(val string) {
-	s.FlightNumber = val
+// SetFeed sets the value of Feed.
+func (s *RequestTransitTrips) SetFeed(val NilString) {
+	s.Feed = val
 }
 
-// SetOriginAirport sets the value of OriginAirport.
-func (s *RequestFlightLeg) SetOriginAirport(val NilString) {
-	s.OriginAirport = val
+// SetFromStopId sets the value of FromStopId.
+func (s *RequestTransitTrips) SetFromStopId(val NilString) {
+	s.FromStopId = val
 }
 
-// Ref: #/components/schemas/request.Train
-type RequestTrain struct {
-	DepartureDate string    `json:"departureDate"`
-	FromStationId string    `json:"fromStationId"`
-	ToStationId   string    `json:"toStationId"`
-	TrainNumbers  []string  `json:"trainNumbers"`
-	ViaStationId  NilString `json:"viaStationId"`
+// SetRouteShortName sets the value of RouteShortName.
+func (s *RequestTransitTrips) SetRouteShortName(val string) {
+	s.RouteShortName = val
 }
 
-// GetDepartureDate returns the value of DepartureDate.
-func (s *RequestTrain) GetDepartureDate() string {
-	return s.DepartureDate
+// SetToStopId sets the value of ToStopId.
+func (s *RequestTransitTrips) SetToStopId(val NilString) {
+	s.ToStopId = val
 }
 
-// GetFromStationId returns the value of FromStationId.
-func (s *RequestTrain) GetFromStationId() string {
-	return s.FromStationId
+// Ref: #/components/schemas/request.Watch
+type RequestWatch struct {
+	Flight           OptRequestFlightLeg       `json:"flight"`
+	PushSubscription OptEntityPushSubscription `json:"pushSubscription"`
+	SubscriberKey    string                    `json:"subscriberKey"`
+	Train            OptRequestTrain           `json:"train"`
+	WebhookUrl       string                    `json:"webhookUrl"`
 }
 
-// GetToStationId returns the value of ToStationId.
-func (s *RequestTrain) GetToStationId() string {
-	return s.ToStationId
+// GetFlight returns the value of Flight.
+func (s *RequestWatch) GetFlight() OptRequestFlightLeg {
+	return s.Flight
 }
 
-// GetTrainNumbers returns the value of TrainNumbers.
-func (s *RequestTrain) GetTrainNumbers() []string {
-	return s.TrainNumbers
+// GetPushSubscription returns the value of PushSubscription.
+func (s *RequestWatch) GetPushSubscription() OptEntityPushSubscription {
+	return s.PushSubscription
 }
 
-// GetViaStationId returns the value of ViaStationId.
-func (s *RequestTrain) GetViaStationId() NilString {
-	return s.ViaStationId
+// GetSubscriberKey returns the value of SubscriberKey.
+func (s *RequestWatch) GetSubscriberKey() string {
+	return s.SubscriberKey
 }
 
-// SetDepartureDate sets the value of DepartureDate.
-func (s *RequestTrain) SetDepartureDate(val string) {
-	s.DepartureDate = val
+// GetTrain returns the value of Train.
+func (s *RequestWatch) GetTrain() OptRequestTrain {
+	return s.Train
 }
 
-// SetFromStationId sets the value of FromStationId.
-func (s *RequestTrain) SetFromStationId(val string) {
-	s.FromStationId = val
+// GetWebhookUrl returns the value of WebhookUrl.
+func (s *RequestWatch) GetWebhookUrl() string {
+	return s.WebhookUrl
 }
 
-// SetToStationId sets the value of ToStationId.
-func (s *RequestTrain) SetToStationId(val string) {
-	s.ToStationId = val
+// SetFlight sets the value of Flight.
+func (s *RequestWatch) SetFlight(val OptRequestFlightLeg) {
+	s.Flight = val
 }
 
-// SetTrainNumbers sets the value of TrainNumbers.
-func (s *RequestTrain) SetTrainNumbers(val []string) {
-	s.TrainNumbers = val
+// SetPushSubscription sets the value of PushSubscription.
+func (s *RequestWatch) SetPushSubscription(val OptEntityPushSubscription) {
+	s.PushSubscription = val
 }
 
-// SetViaStationId sets the value of ViaStationId.
-func (s *RequestTrain) SetViaStationId(val NilString) {
-	s.ViaStationId = val
+// SetSubscriberKey sets the value of SubscriberKey.
+func (s *RequestWatch) SetSubscriberKey(val string) {
+	s.SubscriberKey = val
+}
+
+// SetTrain sets the value of Train.
+func (s *RequestWatch) SetTrain(val OptRequestTrain) {
+	s.Train = val
+}
+
+// SetWebhookUrl sets the value of WebhookUrl.
+func (s *RequestWatch) SetWebhookUrl(val string) {
+	s.WebhookUrl = val
+}
+
+// Ref: #/components/schemas/response.BatchResult
+type ResponseBatchResult struct {
+	Error     OptResponseError   `json:"error"`
+	Flight    OptEntityFlight    `json:"flight"`
+	ID        string             `json:"id"`
+	Train     OptEntityTrain     `json:"train"`
+	TrainTrip OptEntityTrainTrip `json:"trainTrip"`
+}
+
+// GetError returns the value of Error.
+func (s *ResponseBatchResult) GetError() OptResponseError {
+	return s.Error
+}
+
+// GetFlight returns the value of Flight.
+func (s *ResponseBatchResult) GetFlight() OptEntityFlight {
+	return s.Flight
+}
+
+// GetID returns the value of ID.
+func (s *ResponseBatchResult) GetID() string {
+	return s.ID
+}
+
+// GetTrain returns the value of Train.
+func (s *ResponseBatchResult) GetTrain() OptEntityTrain {
+	return s.Train
+}
+
+// GetTrainTrip returns the value of TrainTrip.
+func (s *ResponseBatchResult) GetTrainTrip() OptEntityTrainTrip {
+	return s.TrainTrip
+}
+
+// SetError sets the value of Error.
+func (s *ResponseBatchResult) SetError(val OptResponseError) {
+	s.Error = val
+}
+
+// SetFlight sets the value of Flight.
+func (s *ResponseBatchResult) SetFlight(val OptEntityFlight) {
+	s.Flight = val
+}
+
+// SetID sets the value of ID.
+func (s *ResponseBatchResult) SetID(val string) {
+	s.ID = val
+}
+
+// SetTrain sets the value of Train.
+func (s *ResponseBatchResult) SetTrain(val OptEntityTrain) {
+	s.Train = val
+}
+
+// SetTrainTrip sets the value of TrainTrip.
+func (s *ResponseBatchResult) SetTrainTrip(val OptEntityTrainTrip) {
+	s.TrainTrip = val
 }
 
 // Ref: #/components/schemas/response.Error
 type ResponseError struct {
-	Detail OptNilString `json:"detail"`
-	Error  string       `json:"error"`
+	Code          string                 `json:"code"`
+	Detail        string                 `json:"detail"`
+	Instance      string                 `json:"instance"`
+	InvalidParams []ResponseInvalidParam `json:"invalidParams"`
+	RequestId     string                 `json:"requestId"`
+	Status        int                    `json:"status"`
+	Title         string                 `json:"title"`
+	Type          string                 `json:"type"`
+}
+
+// GetCode returns the value of Code.
+func (s *ResponseError) GetCode() string {
+	return s.Code
 }
 
 // GetDetail returns the value of Detail.
-func (s *ResponseError) GetDetail() OptNilString {
+func (s *ResponseError) GetDetail() string {
 	return s.Detail
 }
 
-// GetError returns the value of Error.
-func (s *ResponseError) GetError() string {
-	return s.Error
+// GetInstance returns the value of Instance.
+func (s *ResponseError) GetInstance() string {
+	return s.Instance
+}
+
+// GetInvalidParams returns the value of InvalidParams.
+func (s *ResponseError) GetInvalidParams() []ResponseInvalidParam {
+	return s.InvalidParams
+}
+
+// GetRequestId returns the value of RequestId.
+func (s *ResponseError) GetRequestId() string {
+	return s.RequestId
+}
+
+// GetStatus returns the value of Status.
+func (s *ResponseError) GetStatus() int {
+	return s.Status
+}
+
+// GetTitle returns the value of Title.
+func (s *ResponseError) GetTitle() string {
+	return s.Title
+}
+
+// GetType returns the value of Type.
+func (s *ResponseError) GetType() string {
+	return s.Type
+}
+
+// SetCode sets the value of Code.
+func (s *ResponseError) SetCode(val string) {
+	s.Code = val
 }
 
 // SetDetail sets the value of Detail.
-func (s *ResponseError) SetDetail(val OptNilString) {
+func (s *ResponseError) SetDetail(val string) {
 	s.Detail = val
 }
 
-// SetError sets the value of Error.
-func (s *ResponseError) SetError(val string) {
-	s.Error = val
+// SetInstance sets the value of Instance.
+func (s *ResponseError) SetInstance(val string) {
+	s.Instance = val
+}
+
+// SetInvalidParams sets the value of InvalidParams.
+func (s *ResponseError) SetInvalidParams(val []ResponseInvalidParam) {
+	s.InvalidParams = val
+}
+
+// SetRequestId sets the value of RequestId.
+func (s *ResponseError) SetRequestId(val string) {
+	s.RequestId = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ResponseError) SetStatus(val int) {
+	s.Status = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ResponseError) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetType sets the value of Type.
+func (s *ResponseError) SetType(val string) {
+	s.Type = val
+}
+
+func (*ResponseError) lookupLocationRes()      {}
+func (*ResponseError) lookupTrainStationRes()  {}
+func (*ResponseError) lookupTransitAlertsRes() {}
+func (*ResponseError) lookupTransitStopsRes()  {}
+func (*ResponseError) retrieveVapidKeyRes()    {}
+
+// Ref: #/components/schemas/response.InvalidParam
+type ResponseInvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// GetName returns the value of Name.
+func (s *ResponseInvalidParam) GetName() string {
+	return s.Name
+}
+
+// GetReason returns the value of Reason.
+func (s *ResponseInvalidParam) GetReason() string {
+	return s.Reason
+}
+
+// SetName sets the value of Name.
+func (s *ResponseInvalidParam) SetName(val string) {
+	s.Name = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ResponseInvalidParam) SetReason(val string) {
+	s.Reason = val
+}
+
+type RetrieveWatchInternalServerError ResponseError
+
+func (*RetrieveWatchInternalServerError) retrieveWatchRes() {}
+
+type RetrieveWatchNotFound ResponseError
+
+func (*RetrieveWatchNotFound) retrieveWatchRes() {}
+
+type ReverseGeocodeBadRequest ResponseError
+
+func (*ReverseGeocodeBadRequest) reverseGeocodeRes() {}
+
+type ReverseGeocodeInternalServerError ResponseError
+
+func (*ReverseGeocodeInternalServerError) reverseGeocodeRes() {}
+
+type ReverseGeocodeOKApplicationJSON []EntityGeocodePlace
+
+func (*ReverseGeocodeOKApplicationJSON) reverseGeocodeRes() {}
+
+type SearchPoisBadRequest ResponseError
+
+func (*SearchPoisBadRequest) searchPoisRes() {}
+
+type SearchPoisInternalServerError ResponseError
+
+func (*SearchPoisInternalServerError) searchPoisRes() {}
+
+type SearchPoisOKApplicationJSON []EntityPoi
+
+func (*SearchPoisOKApplicationJSON) searchPoisRes() {}
+
+type SendPushBadGateway ResponseError
+
+func (*SendPushBadGateway) sendPushRes() {}
+
+type SendPushBadRequest ResponseError
+
+func (*SendPushBadRequest) sendPushRes() {}
+
+type SendPushGone ResponseError
+
+func (*SendPushGone) sendPushRes() {}
+
+// SendPushNoContent is response for SendPush operation.
+type SendPushNoContent struct{}
+
+func (*SendPushNoContent) sendPushRes() {}
+
+type SendPushNotImplemented ResponseError
+
+func (*SendPushNotImplemented) sendPushRes() {}
+
+type StreamLiveBadRequest ResponseError
+
+func (*StreamLiveBadRequest) streamLiveRes() {}
+
+type StreamLiveInternalServerError ResponseError
+
+func (*StreamLiveInternalServerError) streamLiveRes() {}
+
+type StreamLiveOKTextEventStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s StreamLiveOKTextEventStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-func (*ResponseError) lookupLocationRes()     {}
-func (*ResponseError) lookupTrainStationRes() {}
-func (*ResponseError) postFlightRes()         {}
-func (*ResponseError) postTrainJourneyRes()   {}
+func (*StreamLiveOKTextEventStream) streamLiveRes() {}
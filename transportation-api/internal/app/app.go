@@ -2,16 +2,39 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/repo"
 	"kompass/internal/repo/amadeus"
+	"kompass/internal/repo/cache"
 	"kompass/internal/repo/dbvendo"
+	"kompass/internal/repo/geocoder"
+	"kompass/internal/repo/graphhopper"
+	"kompass/internal/repo/gtfs"
+	"kompass/internal/repo/gtfsrt"
+	"kompass/internal/repo/nominatim"
 	"kompass/internal/repo/openrouteservice"
 	"kompass/internal/repo/opentraveldata"
+	"kompass/internal/repo/osrm"
+	"kompass/internal/repo/overpass"
+	"kompass/internal/repo/pelias"
+	"kompass/internal/repo/photon"
+	"kompass/internal/repo/railprovider"
+	"kompass/internal/repo/router"
+	"kompass/internal/repo/searoute"
+	"kompass/internal/repo/valhalla"
+	"kompass/internal/repo/watchstore"
+	"kompass/internal/repo/webhook"
+	"kompass/internal/repo/webpush"
 	"kompass/internal/usecase"
+	"kompass/internal/usecase/batch"
 	"kompass/internal/usecase/flights"
 	"kompass/internal/usecase/geocoding"
+	"kompass/internal/usecase/notifications"
 	"kompass/internal/usecase/trains"
+	"kompass/internal/usecase/transit"
+	"kompass/internal/usecase/watch"
 	"os"
 	"os/signal"
 	"syscall"
@@ -20,19 +43,25 @@ import (
 	"kompass/internal/controller/http"
 	"kompass/pkg/httpserver"
 	"kompass/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
 )
 
 func Run(cfg *config.Config) {
 	log := logger.New(cfg.Log.Level)
 
+	// Background jobs run until shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	// Use-Case
-	useCases := createUseCases(cfg, log)
+	useCases := createUseCases(ctx, cfg, log)
 
 	// HTTP Server
 	httpServer := httpserver.New(
 		httpserver.Port(cfg.HTTP.Port),
 		httpserver.Prefork(cfg.HTTP.UsePreforkMode),
-		httpserver.ErrorHandler(response.ErrorHandler),
+		httpserver.ErrorHandler(response.NewErrorHandler(log)),
 	)
 	http.NewRouter(httpServer.App, cfg, useCases, log)
 	httpServer.Start()
@@ -49,26 +78,107 @@ func Run(cfg *config.Config) {
 	}
 
 	// Shutdown
+	cancel()
 	err := httpServer.Shutdown()
 	if err != nil {
 		log.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
 	}
 }
 
-func createUseCases(cfg *config.Config, log *logger.Logger) usecase.UseCases {
-	ors := openrouteservice.New(cfg.WebApi)
+func createUseCases(ctx context.Context, cfg *config.Config, log *logger.Logger) usecase.UseCases {
+	upstreamCache, err := cache.New(cfg.Cache, log)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createUseCases - cache.New: %w", err))
+	}
+
+	ors := cache.NewOpenRouteServiceWebAPI(openrouteservice.New(cfg.WebApi), upstreamCache)
 	optd, err := opentraveldata.New(cfg.WebApi)
 	if err != nil {
 		log.Fatal(fmt.Errorf("app - createUseCases - opentraveldata.New: %w", err))
 	}
+	gtfsFeeds, err := gtfs.New(cfg.WebApi)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createUseCases - gtfs.New: %w", err))
+	}
+	gtfsFeeds.Start(ctx, log)
 
-	flightsUseCase := flights.New(amadeus.New(cfg.WebApi, optd))
-	trainsUseCase := trains.New(dbvendo.New(cfg.WebApi))
-	geocodingUseCase := geocoding.New(trainsUseCase, ors)
+	flightsUseCase := flights.New(cache.NewFlightInformationWebAPI(amadeus.New(cfg.WebApi, optd), upstreamCache))
+	gtfsRealtime := gtfsrt.New(cfg.WebApi)
+	gtfsRealtime.Start(ctx, log)
+
+	trainsUseCase := trains.New(cache.NewDbVendoWebAPI(railprovider.New(cfg.WebApi, dbvendo.New(cfg.WebApi)), upstreamCache), gtfsRealtime)
+	transitUseCase := transit.New(gtfsFeeds, gtfsRealtime)
+	watchStore, err := watchstore.New(cfg.Watch)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createUseCases - watchstore.New: %w", err))
+	}
+	push, err := webpush.New(cfg.WebPush)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createUseCases - webpush.New: %w", err))
+	}
+	watchUseCase := watch.New(flightsUseCase, trainsUseCase, watchStore, webhook.New(cfg.Watch), push, cfg.Watch.Interval, cfg.Watch.Concurrency)
+	// in prefork mode, the processes share the watch store, but only one polls it
+	if !fiber.IsChild() {
+		watchUseCase.Start(ctx, log)
+	}
+	liveUseCase := watch.NewLive(flightsUseCase, trainsUseCase, cfg.Live.Interval, cfg.Live.Concurrency)
+	liveUseCase.Start(ctx, log)
+
+	geocodingUseCase := geocoding.New(trainsUseCase, createGeocoder(cfg, ors, log), createRouter(cfg, ors, log), ors, createPoiSearch(cfg, ors, log))
 
 	return usecase.UseCases{
 		Geocoding: geocodingUseCase,
 		Flights:   flightsUseCase,
 		Trains:    trainsUseCase,
+		Transit:   transitUseCase,
+		Batch:     batch.New(flightsUseCase, trainsUseCase, cfg.Batch.Concurrency),
+		Watches:   watchUseCase,
+		Live:      liveUseCase,
+		Push:      notifications.New(push),
+	}
+}
+
+func createGeocoder(cfg *config.Config, ors repo.OpenRouteServiceWebAPI, log *logger.Logger) repo.Geocoder {
+	fallback, err := geocoder.New(cfg.WebApi.Geocoders, map[string]repo.Geocoder{
+		"ors":       ors,
+		"nominatim": nominatim.New(cfg.WebApi),
+		"photon":    photon.New(cfg.WebApi),
+		"pelias":    pelias.New(cfg.WebApi),
+	})
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createGeocoder - geocoder.New: %w", err))
+	}
+	return fallback
+}
+
+func createPoiSearch(cfg *config.Config, ors repo.OpenRouteServiceWebAPI, log *logger.Logger) repo.PoiSearch {
+	available := map[string]repo.PoiSearch{
+		"ors":      ors,
+		"overpass": overpass.New(cfg.WebApi),
+	}
+
+	poiSearch, ok := available[cfg.WebApi.PoiSearch]
+	if !ok {
+		log.Fatal(fmt.Errorf("app - createPoiSearch - unknown poi search %s", cfg.WebApi.PoiSearch))
+	}
+	return poiSearch
+}
+
+func createRouter(cfg *config.Config, ors repo.OpenRouteServiceWebAPI, log *logger.Logger) repo.Router {
+	sea, err := searoute.New()
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createRouter - searoute.New: %w", err))
+	}
+
+	table, err := router.New(cfg.WebApi.Routers, cfg.WebApi.DefaultRouter, map[string]repo.Router{
+		"ors":         ors,
+		"osrm":        osrm.New(cfg.WebApi),
+		"valhalla":    valhalla.New(cfg.WebApi),
+		"graphhopper": graphhopper.New(cfg.WebApi),
+		"sea":         sea,
+	})
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - createRouter - router.New: %w", err))
 	}
+	return table
 }
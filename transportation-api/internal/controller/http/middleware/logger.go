@@ -5,6 +5,10 @@ import (
 	fiberLogger "github.com/gofiber/fiber/v2/middleware/logger"
 )
 
+// Logger logs requests with their ID. Errors are left to the error handler,
+// which redacts them.
 func Logger() func(c *fiber.Ctx) error {
-	return fiberLogger.New()
+	return fiberLogger.New(fiberLogger.Config{
+		Format: "${time} | ${locals:" + RequestIDKey + "} | ${status} | ${latency} | ${ip} | ${method} | ${path}\n",
+	})
 }
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// RequestIDKey is the key of the request ID in the locals of the context.
+const RequestIDKey = "requestid"
+
+// requestIDPattern restricts request IDs given by clients, as they end up in
+// the logs.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID correlates responses and logs. The ID is taken from the
+// X-Request-ID header or generated, and returned in the same header.
+func RequestID() func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		id := c.Get(fiber.HeaderXRequestID)
+		if !requestIDPattern.MatchString(id) {
+			id = utils.UUIDv4()
+		}
+
+		c.Set(fiber.HeaderXRequestID, id)
+		c.Locals(RequestIDKey, id)
+		return c.Next()
+	}
+}
+
+// GetRequestID returns the ID set by RequestID.
+func GetRequestID(c *fiber.Ctx) string {
+	id, _ := c.Locals(RequestIDKey).(string)
+	return id
+}
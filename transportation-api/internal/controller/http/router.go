@@ -23,6 +23,7 @@ import (
 // @servers.url http://127.0.0.1:8080/api/v1
 func NewRouter(app *fiber.App, cfg *config.Config, useCases usecase.UseCases, log logger.Interface) {
 	// Options
+	app.Use(middleware.RequestID())
 	app.Use(middleware.Logger())
 	app.Use(middleware.Recovery(log))
 
@@ -47,5 +48,10 @@ func NewRouter(app *fiber.App, cfg *config.Config, useCases usecase.UseCases, lo
 		v1.NewGeocodingRoutes(apiV1Group, useCases.Geocoding, log)
 		v1.NewFlightRoutes(apiV1Group, useCases.Flights, log)
 		v1.NewTrainRoutes(apiV1Group, useCases.Trains, log)
+		v1.NewTransitRoutes(apiV1Group, useCases.Transit, log)
+		v1.NewBatchRoutes(apiV1Group, useCases.Batch, log)
+		v1.NewWatchRoutes(apiV1Group, useCases.Watches, log)
+		v1.NewLiveRoutes(apiV1Group, useCases.Live, log)
+		v1.NewPushRoutes(apiV1Group, useCases.Push, log)
 	}
 }
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BatchV1 struct {
+	uc  usecase.Batch
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Look up flights and trains in a batch
+// @Description Items are validated and processed independently. Each result has the item's ID and either its result or a problem details error.
+// @ID          postBatch
+// @Tags  	    batch
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Batch true "batch"
+// @Success     200 {array} response.BatchResult
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /batch [post]
+func (r *BatchV1) postBatch(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Batch](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	results := make([]response.BatchResult, len(body.Items))
+	valid := []request.BatchItem{}
+	indexes := []int{}
+	for i, item := range body.Items {
+		if err := r.v.Struct(item); err != nil {
+			results[i] = r.newResult(ctx, entity.BatchResult{ID: item.ID, Err: newValidationError(err)})
+			continue
+		}
+		valid = append(valid, item)
+		indexes = append(indexes, i)
+	}
+
+	for i, result := range r.uc.ProcessBatch(ctx.UserContext(), valid) {
+		results[indexes[i]] = r.newResult(ctx, result)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(results)
+}
+
+func (r *BatchV1) newResult(ctx *fiber.Ctx, result entity.BatchResult) response.BatchResult {
+	if result.Err == nil {
+		return response.BatchResult{BatchResult: result}
+	}
+
+	problem := response.NewProblem(ctx, result.Err)
+	if problem.Status >= http.StatusInternalServerError {
+		r.log.Error("http - batch - request %s - item %s: %v", problem.RequestID, result.ID, result.Err)
+	}
+	return response.BatchResult{BatchResult: entity.BatchResult{ID: result.ID}, Error: &problem}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"kompass/internal/controller/http/v1/response"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -11,11 +12,11 @@ func ParseAndValidateRequestBody[V interface{}](ctx *fiber.Ctx, v *validator.Val
 	var body V
 
 	if err := ctx.BodyParser(&body); err != nil {
-		return nil, fmt.Errorf("parse json body: %w", err)
+		return nil, &response.ValidationError{Message: "invalid request body", Err: fmt.Errorf("parse json body: %w", err)}
 	}
 
 	if err := v.Struct(body); err != nil {
-		return nil, fmt.Errorf("validate json body: %w", err)
+		return nil, newValidationError(err)
 	}
 
 	return &body, nil
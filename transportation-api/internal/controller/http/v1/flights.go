@@ -26,13 +26,18 @@ type FlightsV1 struct {
 // @Produce     json
 // @Param       request body request.Flight true "flight"
 // @Success     200 {object} entity.Flight
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
 // @Failure     422 {object} entity.ErrAmbiguousFlightRequest
 // @Failure     500 {object} response.Error
+// @Failure     502 {object} response.Error
+// @Failure     503 {object} response.Error
+// @Failure     504 {object} response.Error
 // @Router      /flights [post]
 func (r *FlightsV1) postFlight(ctx *fiber.Ctx) error {
 	body, err := ParseAndValidateRequestBody[request.Flight](ctx, r.v)
 	if err != nil {
-		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+		return err
 	}
 
 	transportation, err := r.uc.FindFlight(ctx.UserContext(), *body)
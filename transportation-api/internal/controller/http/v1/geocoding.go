@@ -3,9 +3,13 @@ package v1
 import (
 	"fmt"
 	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/entity"
 	"kompass/internal/usecase"
 	"kompass/pkg/logger"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -35,6 +39,64 @@ func (r *GeocodingV1) lookupLocation(ctx *fiber.Ctx) error {
 	return ctx.Status(http.StatusOK).JSON(location)
 }
 
+// @Summary     Autocomplete location
+// @ID          autocompleteLocation
+// @Tags  	    geocoding
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Autocomplete true "autocomplete request"
+// @Success     200 {array} entity.GeocodePlace
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /geocoding/autocomplete [post]
+func (r *GeocodingV1) autocompleteLocation(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Autocomplete](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	places, err := r.uc.AutocompleteLocation(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("autocomplete location: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(places)
+}
+
+// @Summary     Reverse geocode location
+// @ID          reverseGeocode
+// @Tags  	    geocoding
+// @Produce     json
+// @Param       lat query number true "latitude"
+// @Param       lon query number true "longitude"
+// @Success     200 {array} entity.GeocodePlace
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /geocoding/reverse [get]
+func (r *GeocodingV1) reverseGeocode(ctx *fiber.Ctx) error {
+	latitude, err1 := strconv.ParseFloat(ctx.Query("lat"), 32)
+	longitude, err2 := strconv.ParseFloat(ctx.Query("lon"), 32)
+
+	validationError := &response.ValidationError{Message: "invalid coordinates"}
+	if err1 != nil || math.IsNaN(latitude) || math.Abs(latitude) > 90 {
+		validationError.Params = append(validationError.Params, response.InvalidParam{Name: "lat", Reason: "must be a number between -90 and 90"})
+	}
+	if err2 != nil || math.IsNaN(longitude) || math.Abs(longitude) > 180 {
+		validationError.Params = append(validationError.Params, response.InvalidParam{Name: "lon", Reason: "must be a number between -180 and 180"})
+	}
+	if len(validationError.Params) > 0 {
+		return validationError
+	}
+
+	location := entity.Location{Latitude: float32(latitude), Longitude: float32(longitude)}
+	places, err := r.uc.ReverseGeocode(ctx.Context(), location)
+	if err != nil {
+		return fmt.Errorf("reverse geocode: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(places)
+}
+
 // @Summary     Lookup train station
 // @ID          lookupTrainStation
 // @Tags  	    geocoding
@@ -59,20 +121,116 @@ func (r *GeocodingV1) lookupTrainStation(ctx *fiber.Ctx) error {
 // @Accept      json
 // @Produce     json
 // @Param       request body request.Directions true "directions request"
-// @Success     200 {object} geojson.FeatureCollection
+// @Success     200 {object} entity.Directions
 // @Failure     400 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Router      /geocoding/directions [post]
 func (r *GeocodingV1) lookupDirections(ctx *fiber.Ctx) error {
 	body, err := ParseAndValidateRequestBody[request.Directions](ctx, r.v)
 	if err != nil {
-		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+		return err
 	}
 
-	directions, err := r.uc.LookupDirections(ctx.Context(), body.Start, body.End, body.TransportationType)
+	directions, err := r.uc.LookupDirections(ctx.Context(), *body)
 	if err != nil {
 		return fmt.Errorf("lookup directions: %w", err)
 	}
 
 	return ctx.Status(http.StatusOK).JSON(directions)
 }
+
+// @Summary     Plan road trip
+// @ID          planRoadTrip
+// @Tags  	    geocoding
+// @Accept      json
+// @Produce     json
+// @Param       request body request.RoadTrip true "road trip request"
+// @Success     200 {object} entity.RoadTrip
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /geocoding/roadtrip [post]
+func (r *GeocodingV1) planRoadTrip(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.RoadTrip](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	roadTrip, err := r.uc.PlanRoadTrip(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("plan road trip: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(roadTrip)
+}
+
+// @Summary     Lookup travel time matrix
+// @ID          lookupMatrix
+// @Tags  	    geocoding
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Matrix true "matrix request"
+// @Success     200 {object} entity.Matrix
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /geocoding/matrix [post]
+func (r *GeocodingV1) lookupMatrix(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Matrix](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	matrix, err := r.uc.LookupMatrix(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("lookup matrix: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(matrix)
+}
+
+// @Summary     Lookup isochrones
+// @ID          lookupIsochrones
+// @Tags  	    geocoding
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Isochrones true "isochrones request"
+// @Success     200 {object} geojson.FeatureCollection
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /geocoding/isochrones [post]
+func (r *GeocodingV1) lookupIsochrones(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Isochrones](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	isochrones, err := r.uc.LookupIsochrones(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("lookup isochrones: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(isochrones)
+}
+
+// @Summary     Search points of interest
+// @ID          searchPois
+// @Tags  	    geocoding
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Pois true "poi search request"
+// @Success     200 {array} entity.Poi
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /geocoding/pois [post]
+func (r *GeocodingV1) searchPois(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Pois](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	pois, err := r.uc.SearchPois(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("search pois: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(pois)
+}
@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	maxLiveSubjects = 10
+	// heartbeatInterval keeps idle streams open through proxies
+	heartbeatInterval = 15 * time.Second
+	// streams extend the write deadline of the server for each write
+	liveWriteTimeout = 10 * time.Second
+	liveRetry        = 5 * time.Second
+)
+
+type LiveV1 struct {
+	uc  usecase.Live
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Stream live updates
+// @Description Server-Sent Events of the given flights and trains. Each subject is sent as a snapshot event first, then as changed events when delays, platforms, aircraft or cancellations change. Flights are followed by their schedules, which carry no gates or realtime delays, so flights only change with their scheduled times or aircraft. Streams are resumed with the Last-Event-ID header. Comments are sent as heartbeats.
+// @ID          streamLive
+// @Tags  	    live
+// @Produce     text/event-stream
+// @Param       flight query []string false "flights as flightNumber:date[:originAirport], e.g. EK412:2026-01-30" collectionFormat(multi)
+// @Param       train query []string false "train journeys as fromStationId:toStationId:departureDate:trainNumber[,trainNumber], e.g. 8011113:8000261:2025-09-20:ICE707" collectionFormat(multi)
+// @Param       Last-Event-ID header string false "ID of the last received event"
+// @Success     200 {object} entity.LiveEvent
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /live [get]
+func (r *LiveV1) streamLive(ctx *fiber.Ctx) error {
+	subjects, err := r.parseSubjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastEventID := ctx.Get("Last-Event-ID", ctx.Query("lastEventId"))
+	streamCtx, cancel := context.WithCancel(context.Background())
+	events, err := r.uc.Subscribe(streamCtx, subjects, lastEventID)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("subscribe: %w", err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	conn := ctx.Context().Conn()
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		write := func(message string) bool {
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			_, _ = w.WriteString(message)
+			return w.Flush() == nil
+		}
+
+		if !write(fmt.Sprintf("retry: %d\n\n", liveRetry.Milliseconds())) {
+			return
+		}
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					r.log.Error(fmt.Errorf("http - v1 - streamLive - marshal event: %w", err))
+					return
+				}
+				if !write(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)) {
+					return
+				}
+			case <-heartbeat.C:
+				if !write(": heartbeat\n\n") {
+					return
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+func (r *LiveV1) parseSubjects(ctx *fiber.Ctx) ([]entity.LiveSubject, error) {
+	args := ctx.Context().QueryArgs()
+	flights, trains := args.PeekMulti("flight"), args.PeekMulti("train")
+
+	validationError := &response.ValidationError{Message: "invalid subjects"}
+	subjects := []entity.LiveSubject{}
+	for i, value := range flights {
+		subject, err := r.parseFlight(string(value))
+		if err != nil {
+			validationError.Params = append(validationError.Params, subjectParams(fmt.Sprintf("flight[%d]", i), err)...)
+			continue
+		}
+		subjects = append(subjects, subject)
+	}
+	for i, value := range trains {
+		subject, err := r.parseTrain(string(value))
+		if err != nil {
+			validationError.Params = append(validationError.Params, subjectParams(fmt.Sprintf("train[%d]", i), err)...)
+			continue
+		}
+		subjects = append(subjects, subject)
+	}
+
+	switch count := len(flights) + len(trains); {
+	case count == 0:
+		validationError.Params = append(validationError.Params, response.InvalidParam{Name: "flight", Reason: "is required without train"})
+	case count > maxLiveSubjects:
+		validationError.Params = append(validationError.Params, response.InvalidParam{Name: "flight", Reason: fmt.Sprintf("must have at most %d elements together with train", maxLiveSubjects)})
+	}
+	if len(validationError.Params) > 0 {
+		return nil, validationError
+	}
+	return subjects, nil
+}
+
+func (r *LiveV1) parseFlight(value string) (entity.LiveSubject, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return entity.LiveSubject{}, errors.New("must have the format flightNumber:date[:originAirport]")
+	}
+
+	leg := request.FlightLeg{FlightNumber: strings.ReplaceAll(parts[0], " ", "")}
+	leg.Date, _ = civil.ParseDate(parts[1])
+	if len(parts) == 3 {
+		leg.OriginAirport = &parts[2]
+	}
+	leg.Normalize()
+	if err := r.v.Struct(leg); err != nil {
+		return entity.LiveSubject{}, err
+	}
+
+	key := "flight:" + leg.FlightNumber + ":" + leg.Date.String()
+	if leg.OriginAirport != nil {
+		key += ":" + *leg.OriginAirport
+	}
+	return entity.LiveSubject{Key: key, Flight: &entity.WatchedFlight{
+		Date:          leg.Date,
+		FlightNumber:  leg.FlightNumber,
+		OriginAirport: leg.OriginAirport,
+	}}, nil
+}
+
+func (r *LiveV1) parseTrain(value string) (entity.LiveSubject, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 4 {
+		return entity.LiveSubject{}, errors.New("must have the format fromStationId:toStationId:departureDate:trainNumber[,trainNumber]")
+	}
+
+	train := request.Train{FromStationID: parts[0], ToStationID: parts[1], TrainNumbers: strings.Split(parts[3], ",")}
+	train.DepartureDate, _ = civil.ParseDate(parts[2])
+	if err := r.v.Struct(train); err != nil {
+		return entity.LiveSubject{}, err
+	}
+
+	key := strings.Join([]string{"train", train.FromStationID, train.ToStationID, train.DepartureDate.String(), strings.Join(train.TrainNumbers, ",")}, ":")
+	return entity.LiveSubject{Key: key, Train: &entity.WatchedTrain{
+		FromStationID: train.FromStationID,
+		ToStationID:   train.ToStationID,
+		TrainNumbers:  train.TrainNumbers,
+		DepartureDate: train.DepartureDate,
+	}}, nil
+}
+
+// subjectParams reports the invalid fields of a subject below its query
+// parameter.
+func subjectParams(name string, err error) []response.InvalidParam {
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return []response.InvalidParam{{Name: name, Reason: err.Error()}}
+	}
+
+	params := newValidationError(err).Params
+	for i := range params {
+		params[i].Name = name + "." + params[i].Name
+	}
+	return params
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type PushV1 struct {
+	uc  usecase.Notifications
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Retrieve VAPID key
+// @Description The application server key to subscribe to push messages with.
+// @ID          retrieveVapidKey
+// @Tags  	    push
+// @Produce     json
+// @Success     200 {object} entity.VapidKey
+// @Failure     501 {object} response.Error
+// @Router      /push/key [get]
+func (r *PushV1) retrieveVapidKey(ctx *fiber.Ctx) error {
+	key, err := r.uc.VapidKey()
+	if err != nil {
+		return fmt.Errorf("retrieve vapid key: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(key)
+}
+
+// @Summary     Send push notification
+// @Description Sends the notification as encrypted Web Push message. Subscriptions which expired are reported with status 410 and should be removed.
+// @ID          sendPush
+// @Tags  	    push
+// @Accept      json
+// @Param       request body request.Push true "push notification"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     410 {object} response.Error
+// @Failure     501 {object} response.Error
+// @Failure     502 {object} response.Error
+// @Router      /push [post]
+func (r *PushV1) sendPush(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Push](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	if err := r.uc.SendPush(ctx.UserContext(), *body); err != nil {
+		return fmt.Errorf("send push: %w", err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
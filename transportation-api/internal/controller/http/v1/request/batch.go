@@ -0,0 +1,17 @@
+package request
+
+// Batch items are processed independently, so invalid or failing items
+// don't affect the others.
+type Batch struct {
+	Items []BatchItem `json:"items" validate:"min=1,max=100,unique=ID"`
+}
+
+// BatchItem holds exactly one lookup. Monitored legs are refreshed by
+// repeating their lookup.
+type BatchItem struct {
+	ID               string            `json:"id"               validate:"required,max=64" example:"leg-1"`
+	Flight           *Flight           `json:"flight"           validate:"required_without_all=Train TrainTrip TrainTripSection,excluded_with=Train TrainTrip TrainTripSection" binding:"optional"`
+	Train            *Train            `json:"train"            validate:"required_without_all=Flight TrainTrip TrainTripSection,excluded_with=Flight TrainTrip TrainTripSection" binding:"optional"`
+	TrainTrip        *TrainTrip        `json:"trainTrip"        validate:"required_without_all=Flight Train TrainTripSection,excluded_with=Flight Train TrainTripSection" binding:"optional"`
+	TrainTripSection *TrainTripSection `json:"trainTripSection" validate:"required_without_all=Flight Train TrainTrip,excluded_with=Flight Train TrainTrip" binding:"optional"`
+}
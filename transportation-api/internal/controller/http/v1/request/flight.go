@@ -1,15 +1,41 @@
 package request
 
 import (
+	"encoding/json"
+	"strings"
+
 	"cloud.google.com/go/civil"
 )
 
 type FlightLeg struct {
-	Date          civil.Date `json:"date"          example:"2026-01-30"`
-	FlightNumber  string     `json:"flightNumber"  example:"EK412"`
-	OriginAirport *string    `json:"originAirport" extensions:"nullable" example:"SYD"`
+	Date          civil.Date `json:"date"          validate:"required,sane_date" example:"2026-01-30"`
+	FlightNumber  string     `json:"flightNumber"  validate:"required,flight_designator" example:"EK412"`
+	OriginAirport *string    `json:"originAirport" validate:"omitempty,iata_airport" extensions:"nullable" example:"SYD"`
+}
+
+// UnmarshalJSON normalises the leg, as codes are often typed in lower case.
+func (l *FlightLeg) UnmarshalJSON(data []byte) error {
+	type flightLeg FlightLeg
+	var leg flightLeg
+	if err := json.Unmarshal(data, &leg); err != nil {
+		return err
+	}
+
+	*l = FlightLeg(leg)
+	l.Normalize()
+	return nil
+}
+
+// Normalize upper-cases the flight number and origin airport and trims
+// surrounding spaces.
+func (l *FlightLeg) Normalize() {
+	l.FlightNumber = strings.ToUpper(strings.TrimSpace(l.FlightNumber))
+	if l.OriginAirport != nil {
+		origin := strings.ToUpper(strings.TrimSpace(*l.OriginAirport))
+		l.OriginAirport = &origin
+	}
 }
 
 type Flight struct {
-	Legs []FlightLeg `json:"legs"`
+	Legs []FlightLeg `json:"legs" validate:"min=1,max=16,dive"`
 }
@@ -2,8 +2,64 @@ package request
 
 import "kompass/internal/entity"
 
+// Directions are routed from Start via the ordered Waypoints to End.
+// Alternatives is the number of routes to return, including the best one,
+// and is only supported without waypoints.
 type Directions struct {
-	Start              entity.Location           `json:"start"`
-	End                entity.Location           `json:"end"`
-	TransportationType entity.TransportationType `json:"transportationType"`
+	Start              entity.Location           `json:"start"              validate:"required"`
+	Waypoints          []entity.Location         `json:"waypoints"          validate:"max=48,dive,required"`
+	End                entity.Location           `json:"end"                validate:"required"`
+	TransportationType entity.TransportationType `json:"transportationType" validate:"required,transportation_type"`
+	Avoid              []string                  `json:"avoid"              validate:"dive,oneof=tolls highways ferries" example:"tolls,ferries"`
+	Alternatives       *int                      `json:"alternatives"       validate:"omitempty,min=1,max=3" extensions:"nullable"`
+	Units              *string                   `json:"units"              validate:"omitempty,oneof=m km mi" extensions:"nullable" example:"km"`
+	Language           *string                   `json:"language"           validate:"omitempty,bcp47_language_tag" extensions:"nullable" example:"de"`
+}
+
+// RoadTrip is split into stages of at most MaxDailyDrivingMinutes each, with
+// the total driving time spread evenly across the days.
+type RoadTrip struct {
+	Start                  entity.Location           `json:"start"                  validate:"required"`
+	Waypoints              []entity.Location         `json:"waypoints"              validate:"max=48,dive,required"`
+	End                    entity.Location           `json:"end"                    validate:"required"`
+	TransportationType     entity.TransportationType `json:"transportationType"     validate:"required,transportation_type" example:"CAR"`
+	MaxDailyDrivingMinutes int                       `json:"maxDailyDrivingMinutes" validate:"min=60,max=1440" example:"360"`
+	Avoid                  []string                  `json:"avoid"                  validate:"dive,oneof=tolls highways ferries" example:"tolls,ferries"`
+	Language               *string                   `json:"language"               validate:"omitempty,bcp47_language_tag" extensions:"nullable" example:"de"`
+}
+
+// Matrix optionally suggests the order of visiting all locations with the
+// least total travel time, starting at the first location.
+type Matrix struct {
+	Locations          []entity.Location         `json:"locations"          validate:"min=2,max=50,dive"`
+	TransportationType entity.TransportationType `json:"transportationType" validate:"required,transportation_type" example:"HIKE"`
+	Optimize           bool                      `json:"optimize"`
+}
+
+// Isochrones are computed around each of the Locations. Ranges are given in
+// seconds for the time and in meters for the distance RangeType.
+type Isochrones struct {
+	Locations          []entity.Location         `json:"locations"          validate:"min=1,max=5,dive"`
+	TransportationType entity.TransportationType `json:"transportationType" validate:"required,transportation_type" example:"BIKE"`
+	RangeType          string                    `json:"rangeType"          validate:"oneof=time distance" example:"time"`
+	Ranges             []int                     `json:"ranges"             validate:"min=1,max=10,dive,min=1" example:"600,1200"`
+}
+
+// Pois are searched within the Radius (m) around the Location, or within the
+// BoundingBox [minLongitude, minLatitude, maxLongitude, maxLatitude] if set.
+// Distances are always relative to the Location.
+type Pois struct {
+	Location    entity.Location `json:"location"    validate:"required"`
+	Radius      *int            `json:"radius"      validate:"omitempty,min=1,max=2000" extensions:"nullable" example:"500"`
+	BoundingBox []float64       `json:"boundingBox" validate:"omitempty,bounding_box" extensions:"nullable"`
+	Categories  []string        `json:"categories"  validate:"dive,oneof=restaurant supermarket pharmacy sight" example:"restaurant,pharmacy"`
+	Size        *int            `json:"size"        validate:"omitempty,min=1,max=100" extensions:"nullable"`
+}
+
+type Autocomplete struct {
+	Text      string           `json:"text"      validate:"required" example:"Brandenburger Tor"`
+	Focus     *entity.Location `json:"focus"     binding:"optional"`
+	Countries []string         `json:"countries" validate:"dive,iso3166_1_alpha2" example:"DE,AT"`
+	Layers    []string         `json:"layers"    validate:"dive,oneof=venue address street neighbourhood borough localadmin locality county macrocounty region macroregion country coarse postalcode" example:"venue,address"`
+	Size      *int             `json:"size"      validate:"omitempty,min=1,max=40" extensions:"nullable"`
 }
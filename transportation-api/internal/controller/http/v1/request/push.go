@@ -0,0 +1,15 @@
+package request
+
+import (
+	"encoding/json"
+	"kompass/internal/entity"
+)
+
+type Push struct {
+	Subscription entity.PushSubscription `json:"subscription" validate:"required"`
+	// Notification is sent as is, so its JSON must fit into a push message.
+	Notification json.RawMessage `json:"notification" validate:"required" swaggertype:"object"`
+	TTL          *int            `json:"ttl"          validate:"omitempty,min=0,max=2419200" extensions:"nullable" example:"3600"`
+	Urgency      *string         `json:"urgency"      validate:"omitempty,oneof=very-low low normal high" extensions:"nullable" example:"high"`
+	Topic        *string         `json:"topic"        validate:"omitempty,alphanum,max=32" extensions:"nullable" example:"EK412"`
+}
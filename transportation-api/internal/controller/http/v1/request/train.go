@@ -3,9 +3,21 @@ package request
 import "cloud.google.com/go/civil"
 
 type Train struct {
-	FromStationID string     `json:"fromStationId" example:"8011113"`
-	ToStationID   string     `json:"toStationId"   example:"8000261"`
-	TrainNumbers  []string   `json:"trainNumbers"  example:"ICE707"`
-	DepartureDate civil.Date `json:"departureDate" example:"2025-09-20"`
-	ViaStationID  *string    `json:"viaStationId"  example:"8596008" extensions:"nullable"`
+	FromStationID string     `json:"fromStationId" validate:"required,ibnr" example:"8011113"`
+	ToStationID   string     `json:"toStationId"   validate:"required,ibnr" example:"8000261"`
+	TrainNumbers  []string   `json:"trainNumbers"  validate:"min=1,max=8,dive,required,max=32" example:"ICE707"`
+	DepartureDate civil.Date `json:"departureDate" validate:"required,sane_date" example:"2025-09-20"`
+	ViaStationID  *string    `json:"viaStationId"  validate:"omitempty,ibnr" example:"8596008" extensions:"nullable"`
+	Provider      *string    `json:"provider"      validate:"omitempty,alphanum,max=32" example:"oebb" extensions:"nullable"`
+}
+
+type TrainTrip struct {
+	TrainNumber   string     `json:"trainNumber"   validate:"required,max=32" example:"ICE 707"`
+	DepartureDate civil.Date `json:"departureDate" validate:"required,sane_date" example:"2025-09-20"`
+}
+
+type TrainTripSection struct {
+	TrainTrip
+	FromStationID string `json:"fromStationId" validate:"required,ibnr" example:"8011113"`
+	ToStationID   string `json:"toStationId"   validate:"required,ibnr" example:"8000261"`
 }
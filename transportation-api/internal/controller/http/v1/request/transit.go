@@ -0,0 +1,23 @@
+package request
+
+import "cloud.google.com/go/civil"
+
+type TransitTrips struct {
+	RouteShortName string     `json:"routeShortName" validate:"required" example:"N1001"`
+	Date           civil.Date `json:"date"           validate:"required,sane_date" example:"2026-07-14"`
+	FromStopID     *string    `json:"fromStopId"     extensions:"nullable" example:"dcc1e8a8-9603-11e6-9066-549f350fcb0c"`
+	ToStopID       *string    `json:"toStopId"       extensions:"nullable" example:"dcbb5de2-9603-11e6-9066-549f350fcb0c"`
+	Feed           *string    `json:"feed"           extensions:"nullable" example:"flixbus"`
+}
+
+type TransitLeg struct {
+	Feed       string     `json:"feed"       validate:"required" example:"flixbus"`
+	TripID     string     `json:"tripId"     validate:"required" example:"N1001-1-1068012023-ZZ"`
+	Date       civil.Date `json:"date"       validate:"required,sane_date" example:"2026-07-14"`
+	FromStopID string     `json:"fromStopId" validate:"required" example:"dcc1e8a8-9603-11e6-9066-549f350fcb0c"`
+	ToStopID   string     `json:"toStopId"   validate:"required" example:"dcbb5de2-9603-11e6-9066-549f350fcb0c"`
+}
+
+type Transit struct {
+	Legs []TransitLeg `json:"legs" validate:"min=1,max=16,dive"`
+}
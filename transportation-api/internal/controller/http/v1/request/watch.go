@@ -0,0 +1,13 @@
+package request
+
+import "kompass/internal/entity"
+
+// Watch registers exactly one flight leg or train journey. Changes are sent
+// to the webhook, as push notification or both.
+type Watch struct {
+	SubscriberKey    string                   `json:"subscriberKey"    validate:"required,max=128" example:"user-42"`
+	WebhookURL       string                   `json:"webhookUrl"       validate:"required_without=PushSubscription,omitempty,https_url,max=2048" example:"https://worker.example.com/webhooks/kompass"`
+	PushSubscription *entity.PushSubscription `json:"pushSubscription" validate:"required_without=WebhookURL,omitempty" binding:"optional"`
+	Flight           *FlightLeg               `json:"flight"           validate:"required_without=Train,excluded_with=Train" binding:"optional"`
+	Train            *Train                   `json:"train"            validate:"required_without=Flight,excluded_with=Flight" binding:"optional"`
+}
@@ -0,0 +1,11 @@
+package response
+
+import (
+	"kompass/internal/entity"
+)
+
+// BatchResult has either one of the results or the error of the item.
+type BatchResult struct {
+	entity.BatchResult
+	Error *Error `json:"error,omitempty" validate:"optional"`
+}
@@ -2,25 +2,167 @@ package response
 
 import (
 	"errors"
+	"kompass/internal/controller/http/middleware"
+	"kompass/internal/entity"
+	"kompass/pkg/logger"
+	"net/http"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 )
 
+const problemContentType = "application/problem+json"
+
+// Error is an RFC 9457 problem details object.
 type Error struct {
-	Error  string  `json:"error"`
-	Detail *string `json:"detail,omitempty" validate:"optional" extensions:"nullable"`
+	Type          string         `json:"type"                    example:"urn:kompass:problem:not-found"`
+	Title         string         `json:"title"                   example:"Not Found"`
+	Status        int            `json:"status"                  example:"404"`
+	Detail        string         `json:"detail,omitempty"        example:"no matching flight found"`
+	Instance      string         `json:"instance"                example:"/api/v1/flights"`
+	Code          string         `json:"code"                    example:"NOT_FOUND"`
+	RequestID     string         `json:"requestId"               example:"5f0c3a4e-9a4b-4c1e-8d0e-6a2b1f3c4d5e"`
+	InvalidParams []InvalidParam `json:"invalidParams,omitempty" validate:"optional"`
+}
+
+// InvalidParam is a request field which failed validation.
+type InvalidParam struct {
+	Name   string `json:"name"   example:"departureDate"`
+	Reason string `json:"reason" example:"is required"`
+}
+
+// ValidationError is returned for request bodies which couldn't be parsed
+// or validated.
+type ValidationError struct {
+	Message string
+	Params  []InvalidParam
+	Err     error
+}
+
+func (e *ValidationError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// statuses of the error kinds. Errors joined from several sources are
+// classified by the first matching kind, so a location which any geocoder
+// didn't find is reported as not found rather than as upstream failure.
+var statuses = []struct {
+	kind   entity.ErrorKind
+	status int
+}{
+	{entity.ErrInvalidInput, fiber.StatusBadRequest},
+	{entity.ErrNotFound, fiber.StatusNotFound},
+	{entity.ErrAmbiguous, fiber.StatusUnprocessableEntity},
+	{entity.ErrSubscriptionExpired, fiber.StatusGone},
+	{entity.ErrNotConfigured, fiber.StatusNotImplemented},
+	{entity.ErrRateLimited, fiber.StatusServiceUnavailable},
+	{entity.ErrUpstreamTimeout, fiber.StatusGatewayTimeout},
+	{entity.ErrUpstreamUnavailable, fiber.StatusBadGateway},
+}
+
+// NewErrorHandler responds with problem details. Server errors are logged
+// with the request ID, while their details are only returned as far as they
+// were written for clients.
+func NewErrorHandler(log logger.Interface) fiber.ErrorHandler {
+	return func(c *fiber.Ctx, err error) error {
+		problem := NewProblem(c, err)
+
+		if problem.Status >= fiber.StatusInternalServerError {
+			log.Error("http - %s %s - request %s: %v", c.Method(), c.Path(), problem.RequestID, err)
+		} else {
+			log.Debug("http - %s %s - request %s: %v", c.Method(), c.Path(), problem.RequestID, err)
+		}
+
+		return c.Status(problem.Status).JSON(problem, problemContentType)
+	}
 }
 
-func ErrorHandler(c *fiber.Ctx, err error) error {
+// NewProblem describes the error of the request. Details are only included
+// as far as they were written for clients.
+func NewProblem(c *fiber.Ctx, err error) Error {
+	problem := newProblem(err)
+	problem.Instance = c.Path()
+	problem.RequestID = middleware.GetRequestID(c)
+	return problem
+}
+
+func newProblem(err error) Error {
+	var validationError *ValidationError
+	if errors.As(err, &validationError) {
+		problem := problemOf(fiber.StatusBadRequest, string(entity.ErrInvalidInput))
+		problem.Detail = validationError.Message
+		problem.InvalidParams = validationError.Params
+		return problem
+	}
+
 	var e *fiber.Error
 	if errors.As(err, &e) {
-		return c.Status(e.Code).JSON(Error{
-			Error: e.Error(),
-		})
+		problem := problemOf(e.Code, statusCode(e.Code))
+		problem.Detail = logger.Redact(e.Message)
+		return problem
 	}
 
-	detailedMsg := err.Error()
-	return c.Status(fiber.StatusInternalServerError).JSON(Error{
-		Error:  "internal server error",
-		Detail: &detailedMsg,
-	})
+	for _, s := range statuses {
+		if errors.Is(err, s.kind) {
+			problem := problemOf(s.status, string(s.kind))
+			problem.Detail = s.kind.Error()
+			if domainError := findError(err, s.kind); domainError != nil {
+				problem.Detail = logger.Redact(domainError.Message)
+			}
+			return problem
+		}
+	}
+
+	return problemOf(fiber.StatusInternalServerError, statusCode(fiber.StatusInternalServerError))
+}
+
+// findError returns the first domain error of the kind, also within joined
+// errors.
+func findError(err error, kind entity.ErrorKind) *entity.Error {
+	if e, ok := err.(*entity.Error); ok && e.Kind == kind {
+		return e
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := wrapped.Unwrap(); inner != nil {
+			return findError(inner, kind)
+		}
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			if e := findError(inner, kind); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func problemOf(status int, code string) Error {
+	return Error{
+		Type:   "urn:kompass:problem:" + strings.ToLower(strings.ReplaceAll(code, "_", "-")),
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+	}
+}
+
+// statusCode derives a code like METHOD_NOT_ALLOWED from the HTTP status of
+// errors raised by the controllers.
+func statusCode(status int) string {
+	switch status {
+	case fiber.StatusBadRequest:
+		return string(entity.ErrInvalidInput)
+	case fiber.StatusNotFound:
+		return string(entity.ErrNotFound)
+	default:
+		return strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+	}
 }
@@ -0,0 +1,106 @@
+package response
+
+import (
+	"errors"
+	"fmt"
+	"kompass/internal/entity"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+		detail string
+	}{
+		{
+			name:   "domain error",
+			err:    entity.NewError(entity.ErrNotFound, "no matching flight found"),
+			status: fiber.StatusNotFound,
+			code:   "NOT_FOUND",
+			detail: "no matching flight found",
+		},
+		{
+			name:   "wrapped domain error",
+			err:    fmt.Errorf("retrieve journey: %w", entity.WrapError(entity.ErrUpstreamTimeout, "upstream service timed out", errors.New("dial tcp"))),
+			status: fiber.StatusGatewayTimeout,
+			code:   "UPSTREAM_TIMEOUT",
+			detail: "upstream service timed out",
+		},
+		{
+			name:   "joined errors prefer the first kind of the table",
+			err:    errors.Join(entity.NewError(entity.ErrUpstreamUnavailable, "upstream service unavailable"), entity.NewError(entity.ErrNotFound, "no locations found")),
+			status: fiber.StatusNotFound,
+			code:   "NOT_FOUND",
+			detail: "no locations found",
+		},
+		{
+			name:   "kind without message",
+			err:    fmt.Errorf("send push: %w", entity.ErrRateLimited),
+			status: fiber.StatusServiceUnavailable,
+			code:   "RATE_LIMITED",
+			detail: "rate limited",
+		},
+		{
+			name:   "validation error",
+			err:    &ValidationError{Message: "invalid request body", Params: []InvalidParam{{Name: "date", Reason: "is required"}}},
+			status: fiber.StatusBadRequest,
+			code:   "INVALID_INPUT",
+			detail: "invalid request body",
+		},
+		{
+			name:   "status error",
+			err:    fiber.NewError(fiber.StatusMethodNotAllowed, "Method Not Allowed"),
+			status: fiber.StatusMethodNotAllowed,
+			code:   "METHOD_NOT_ALLOWED",
+			detail: "Method Not Allowed",
+		},
+		{
+			name:   "unclassified error",
+			err:    errors.New("parse timestamp"),
+			status: fiber.StatusInternalServerError,
+			code:   "INTERNAL_SERVER_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := newProblem(tt.err)
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, tt.code, problem.Code)
+			assert.Equal(t, tt.detail, problem.Detail)
+		})
+	}
+}
+
+func TestNewProblemInvalidParams(t *testing.T) {
+	params := []InvalidParam{{Name: "legs[0].date", Reason: "is required"}}
+	problem := newProblem(fmt.Errorf("parse body: %w", &ValidationError{Message: "invalid request body", Params: params}))
+
+	assert.Equal(t, "urn:kompass:problem:invalid-input", problem.Type)
+	assert.Equal(t, "Bad Request", problem.Title)
+	assert.Equal(t, params, problem.InvalidParams)
+}
+
+func TestStatuses(t *testing.T) {
+	for _, s := range statuses {
+		problem := newProblem(entity.NewError(s.kind, "message"))
+		assert.Equal(t, s.status, problem.Status, s.kind)
+		assert.Equal(t, string(s.kind), problem.Code)
+		assert.Equal(t, "message", problem.Detail)
+	}
+}
+
+func TestFindError(t *testing.T) {
+	notFound := entity.NewError(entity.ErrNotFound, "no matching trip found")
+	joined := errors.Join(errors.New("first"), fmt.Errorf("second: %w", notFound))
+
+	assert.Same(t, notFound, findError(fmt.Errorf("retrieve trip: %w", joined), entity.ErrNotFound))
+	assert.Nil(t, findError(joined, entity.ErrAmbiguous))
+	assert.Nil(t, findError(errors.New("plain"), entity.ErrNotFound))
+}
@@ -4,28 +4,67 @@ import (
 	"kompass/internal/usecase"
 	"kompass/pkg/logger"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 )
 
 func NewGeocodingRoutes(apiV1Group fiber.Router, uc usecase.Geocoding, log logger.Interface) {
-	r := &GeocodingV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+	r := &GeocodingV1{uc: uc, log: log, v: newValidator()}
 
 	geocodingV1Group := apiV1Group.Group("/geocoding")
 
 	{
 		geocodingV1Group.Post("/location", r.lookupLocation)
+		geocodingV1Group.Post("/autocomplete", r.autocompleteLocation)
+		geocodingV1Group.Get("/reverse", r.reverseGeocode)
 		geocodingV1Group.Post("/station", r.lookupTrainStation)
 		geocodingV1Group.Post("/directions", r.lookupDirections)
+		geocodingV1Group.Post("/roadtrip", r.planRoadTrip)
+		geocodingV1Group.Post("/matrix", r.lookupMatrix)
+		geocodingV1Group.Post("/isochrones", r.lookupIsochrones)
+		geocodingV1Group.Post("/pois", r.searchPois)
 	}
 }
 
 func NewFlightRoutes(apiV1Group fiber.Router, uc usecase.Flights, log logger.Interface) {
-	r := &FlightsV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+	r := &FlightsV1{uc: uc, log: log, v: newValidator()}
 	apiV1Group.Post("/flights", r.postFlight)
 }
 
 func NewTrainRoutes(apiV1Group fiber.Router, uc usecase.Trains, log logger.Interface) {
-	r := &TrainsV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+	r := &TrainsV1{uc: uc, log: log, v: newValidator()}
 	apiV1Group.Post("/trains", r.postTrainJourney)
+	apiV1Group.Post("/trains/trip", r.postTrainTrip)
+	apiV1Group.Post("/trains/trip/section", r.postTrainTripSection)
+}
+
+func NewBatchRoutes(apiV1Group fiber.Router, uc usecase.Batch, log logger.Interface) {
+	r := &BatchV1{uc: uc, log: log, v: newValidator()}
+	apiV1Group.Post("/batch", r.postBatch)
+}
+
+func NewWatchRoutes(apiV1Group fiber.Router, uc usecase.Watches, log logger.Interface) {
+	r := &WatchesV1{uc: uc, log: log, v: newValidator()}
+	apiV1Group.Post("/watches", r.createWatch)
+	apiV1Group.Get("/watches", r.listWatches)
+	apiV1Group.Get("/watches/:id", r.retrieveWatch)
+	apiV1Group.Delete("/watches/:id", r.deleteWatch)
+}
+
+func NewLiveRoutes(apiV1Group fiber.Router, uc usecase.Live, log logger.Interface) {
+	r := &LiveV1{uc: uc, log: log, v: newValidator()}
+	apiV1Group.Get("/live", r.streamLive)
+}
+
+func NewPushRoutes(apiV1Group fiber.Router, uc usecase.Notifications, log logger.Interface) {
+	r := &PushV1{uc: uc, log: log, v: newValidator()}
+	apiV1Group.Get("/push/key", r.retrieveVapidKey)
+	apiV1Group.Post("/push", r.sendPush)
+}
+
+func NewTransitRoutes(apiV1Group fiber.Router, uc usecase.Transit, log logger.Interface) {
+	r := &TransitV1{uc: uc, log: log, v: newValidator()}
+	apiV1Group.Post("/transit", r.postTransit)
+	apiV1Group.Post("/transit/trips", r.postTransitTrips)
+	apiV1Group.Post("/transit/stops", r.lookupStops)
+	apiV1Group.Post("/transit/alerts", r.lookupAlerts)
 }
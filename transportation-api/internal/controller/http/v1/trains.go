@@ -24,12 +24,17 @@ type TrainsV1 struct {
 // @Produce     json
 // @Param       request body request.Train true "train journey"
 // @Success     200 {object} entity.Train
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
 // @Failure     500 {object} response.Error
+// @Failure     502 {object} response.Error
+// @Failure     503 {object} response.Error
+// @Failure     504 {object} response.Error
 // @Router      /trains [post]
 func (r *TrainsV1) postTrainJourney(ctx *fiber.Ctx) error {
 	body, err := ParseAndValidateRequestBody[request.Train](ctx, r.v)
 	if err != nil {
-		return fiber.NewError(http.StatusBadRequest, "parse request body")
+		return err
 	}
 
 	transportation, err := r.uc.FindTrainJourney(ctx.Context(), *body)
@@ -39,3 +44,59 @@ func (r *TrainsV1) postTrainJourney(ctx *fiber.Ctx) error {
 
 	return ctx.Status(http.StatusOK).JSON(transportation)
 }
+
+// @Summary     Find train trip by train number
+// @ID          postTrainTrip
+// @Tags  	    trains
+// @Accept      json
+// @Produce     json
+// @Param       request body request.TrainTrip true "train trip"
+// @Success     200 {object} entity.TrainTrip
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Failure     502 {object} response.Error
+// @Failure     503 {object} response.Error
+// @Failure     504 {object} response.Error
+// @Router      /trains/trip [post]
+func (r *TrainsV1) postTrainTrip(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.TrainTrip](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	trip, err := r.uc.FindTrainTrip(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("retrieve trip: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(trip)
+}
+
+// @Summary     Find train journey from a section of a train trip
+// @ID          postTrainTripSection
+// @Tags  	    trains
+// @Accept      json
+// @Produce     json
+// @Param       request body request.TrainTripSection true "train trip section"
+// @Success     200 {object} entity.Train
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Failure     502 {object} response.Error
+// @Failure     503 {object} response.Error
+// @Failure     504 {object} response.Error
+// @Router      /trains/trip/section [post]
+func (r *TrainsV1) postTrainTripSection(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.TrainTripSection](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	transportation, err := r.uc.FindTrainTripSection(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("retrieve trip section: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(transportation)
+}
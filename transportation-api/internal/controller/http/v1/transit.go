@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TransitV1 struct {
+	uc  usecase.Transit
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Lookup transit stops
+// @ID          lookupTransitStops
+// @Tags  	    transit
+// @Produce     json
+// @Param       query query string true "stop query"
+// @Success     200 {array} entity.TransitStop
+// @Failure     500 {object} response.Error
+// @Router      /transit/stops [post]
+func (r *TransitV1) lookupStops(ctx *fiber.Ctx) error {
+	query := ctx.Query("query")
+	stops, err := r.uc.LookupStops(ctx.Context(), query)
+	if err != nil {
+		return fmt.Errorf("lookup stops: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(stops)
+}
+
+// @Summary     Find transit trips by route and date
+// @ID          postTransitTrips
+// @Tags  	    transit
+// @Accept      json
+// @Produce     json
+// @Param       request body request.TransitTrips true "transit trips"
+// @Success     200 {array} entity.TransitLeg
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /transit/trips [post]
+func (r *TransitV1) postTransitTrips(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.TransitTrips](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	legs, err := r.uc.FindTrips(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("retrieve trips: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(legs)
+}
+
+// @Summary     Find transit
+// @ID          postTransit
+// @Tags  	    transit
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Transit true "transit"
+// @Success     200 {object} entity.Transit
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /transit [post]
+func (r *TransitV1) postTransit(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Transit](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	transportation, err := r.uc.FindTransit(ctx.Context(), *body)
+	if err != nil {
+		return fmt.Errorf("retrieve transit: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(transportation)
+}
+
+// @Summary     Lookup active service alerts
+// @ID          lookupTransitAlerts
+// @Tags  	    transit
+// @Produce     json
+// @Param       feed query string false "GTFS feed"
+// @Param       routeId query string false "route id"
+// @Param       stopId query string false "stop id"
+// @Success     200 {array} entity.ServiceAlert
+// @Failure     500 {object} response.Error
+// @Router      /transit/alerts [post]
+func (r *TransitV1) lookupAlerts(ctx *fiber.Ctx) error {
+	alerts, err := r.uc.FindAlerts(ctx.Context(), optionalQuery(ctx, "feed"), optionalQuery(ctx, "routeId"), optionalQuery(ctx, "stopId"))
+	if err != nil {
+		return fmt.Errorf("lookup alerts: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(alerts)
+}
+
+func optionalQuery(ctx *fiber.Ctx, key string) *string {
+	value := ctx.Query(key)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
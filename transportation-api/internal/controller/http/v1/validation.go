@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/entity"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/go-playground/validator/v10"
+)
+
+// Dates further in the future have no schedules yet, older ones are most
+// likely typos.
+const (
+	maxDaysBeforeToday = 5 * 365
+	maxDaysAfterToday  = 365
+)
+
+var (
+	iataAirportPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	// airline designators have two characters, at least one of them a letter
+	iataAirlinePattern      = regexp.MustCompile(`^([A-Z][A-Z0-9]|[0-9][A-Z])$`)
+	flightDesignatorPattern = regexp.MustCompile(`^([A-Z0-9]{2}) ?([0-9]{1,4}[A-Z]?)$`)
+	// IBNR station IDs have 7 digits, but some providers pad them to 9
+	ibnrPattern = regexp.MustCompile(`^[0-9]{7,9}$`)
+)
+
+// newValidator reports fields by their JSON names and registers the custom
+// validations of the request types.
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// dates are validated as time, invalid dates as missing
+	v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		date := field.Interface().(civil.Date)
+		if !date.IsValid() {
+			return nil
+		}
+		return date.In(time.UTC)
+	}, civil.Date{})
+
+	for tag, fn := range map[string]validator.Func{
+		"iata_airport":        matches(iataAirportPattern),
+		"flight_designator":   validateFlightDesignator,
+		"ibnr":                matches(ibnrPattern),
+		"transportation_type": validateTransportationType,
+		"sane_date":           validateSaneDate,
+		"bounding_box":        validateBoundingBox,
+	} {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			panic(fmt.Sprintf("register validation %s: %v", tag, err))
+		}
+	}
+	return v
+}
+
+func matches(pattern *regexp.Regexp) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return pattern.MatchString(fl.Field().String())
+	}
+}
+
+func validateFlightDesignator(fl validator.FieldLevel) bool {
+	match := flightDesignatorPattern.FindStringSubmatch(fl.Field().String())
+	return match != nil && iataAirlinePattern.MatchString(match[1])
+}
+
+func validateTransportationType(fl validator.FieldLevel) bool {
+	return entity.TransportationType(fl.Field().String()).IsValid()
+}
+
+func validateSaneDate(fl validator.FieldLevel) bool {
+	date, ok := fl.Field().Interface().(time.Time)
+	if !ok {
+		return false
+	}
+
+	today := civil.DateOf(time.Now())
+	d := civil.DateOf(date)
+	return !d.Before(today.AddDays(-maxDaysBeforeToday)) && !d.After(today.AddDays(maxDaysAfterToday))
+}
+
+// validateBoundingBox checks [minLongitude, minLatitude, maxLongitude, maxLatitude].
+func validateBoundingBox(fl validator.FieldLevel) bool {
+	box, ok := fl.Field().Interface().([]float64)
+	if !ok || len(box) != 4 {
+		return false
+	}
+
+	minLon, minLat, maxLon, maxLat := box[0], box[1], box[2], box[3]
+	return minLon >= -180 && maxLon <= 180 && minLat >= -90 && maxLat <= 90 &&
+		minLon < maxLon && minLat < maxLat
+}
+
+func newValidationError(err error) *response.ValidationError {
+	validationError := &response.ValidationError{Message: "invalid request body", Err: fmt.Errorf("validate json body: %w", err)}
+
+	var fieldErrors validator.ValidationErrors
+	if errors.As(err, &fieldErrors) {
+		for _, fe := range fieldErrors {
+			// the namespace starts with the name of the request type
+			_, name, _ := strings.Cut(fe.Namespace(), ".")
+			validationError.Params = append(validationError.Params, response.InvalidParam{
+				Name:   name,
+				Reason: reason(fe),
+			})
+		}
+	}
+	return validationError
+}
+
+func reason(fe validator.FieldError) string {
+	verb, unit := "be", ""
+	switch fe.Kind() {
+	case reflect.String:
+		verb, unit = "have", " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		verb, unit = "have", " elements"
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must %s at least %s%s", verb, fe.Param(), unit)
+	case "max":
+		return fmt.Sprintf("must %s at most %s%s", verb, fe.Param(), unit)
+	case "len":
+		return fmt.Sprintf("must %s exactly %s%s", verb, fe.Param(), unit)
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", fe.Param())
+	case "unique":
+		return fmt.Sprintf("must not contain duplicate %ss", jsonNames(fe.Param()))
+	case "required_without":
+		return fmt.Sprintf("is required without %s", jsonNames(fe.Param()))
+	case "required_without_all":
+		return fmt.Sprintf("is required without %s", jsonNames(fe.Param()))
+	case "excluded_with":
+		return fmt.Sprintf("must not be combined with %s", jsonNames(fe.Param()))
+	case "http_url":
+		return "must be an HTTP or HTTPS URL"
+	case "https_url":
+		return "must be an HTTPS URL"
+	case "latitude":
+		return "must be between -90 and 90"
+	case "longitude":
+		return "must be between -180 and 180"
+	case "iata_airport":
+		return "must be a three letter IATA airport code"
+	case "flight_designator":
+		return "must be an IATA airline code followed by a flight number of up to four digits"
+	case "ibnr":
+		return "must be an IBNR station ID of seven digits"
+	case "transportation_type":
+		names := make([]string, len(entity.TransportationTypes))
+		for i, t := range entity.TransportationTypes {
+			names[i] = t.String()
+		}
+		return "must be one of " + strings.Join(names, " ")
+	case "sane_date":
+		return fmt.Sprintf("must be at most %d days before and %d days after today", maxDaysBeforeToday, maxDaysAfterToday)
+	case "bounding_box":
+		return "must be [minLongitude, minLatitude, maxLongitude, maxLatitude] with valid coordinates"
+	default:
+		if fe.Param() != "" {
+			return fmt.Sprintf("must satisfy %s=%s", fe.Tag(), fe.Param())
+		}
+		return fmt.Sprintf("must satisfy %s", fe.Tag())
+	}
+}
+
+// jsonNames converts the field names of tag parameters to their JSON names,
+// which are the same except for the first letter and trailing acronyms.
+func jsonNames(fields string) string {
+	names := strings.Fields(fields)
+	for i, name := range names {
+		for _, acronym := range []string{"ID", "URL"} {
+			if base, ok := strings.CutSuffix(name, acronym); ok {
+				name = base + acronym[:1] + strings.ToLower(acronym[1:])
+			}
+		}
+		names[i] = strings.ToLower(name[:1]) + name[1:]
+	}
+	return strings.Join(names, ", ")
+}
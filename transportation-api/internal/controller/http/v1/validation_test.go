@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"encoding/json"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/entity"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFlight(t *testing.T) {
+	v := newValidator()
+	today := civil.DateOf(time.Now())
+	origin := "SYD"
+
+	valid := request.Flight{Legs: []request.FlightLeg{
+		{Date: today, FlightNumber: "EK412", OriginAirport: &origin},
+		{Date: today.AddDays(30), FlightNumber: "U2 1234"},
+		{Date: today, FlightNumber: "4U123A"},
+	}}
+	assert.NoError(t, v.Struct(valid))
+
+	invalidOrigin := "syd"
+	invalid := request.Flight{Legs: []request.FlightLeg{
+		{Date: today.AddDays(400), FlightNumber: "12345", OriginAirport: &invalidOrigin},
+		{FlightNumber: "EK12345"},
+	}}
+	err := v.Struct(invalid)
+	require.Error(t, err)
+
+	assert.ElementsMatch(t, []response.InvalidParam{
+		{Name: "legs[0].date", Reason: "must be at most 1825 days before and 365 days after today"},
+		{Name: "legs[0].flightNumber", Reason: "must be an IATA airline code followed by a flight number of up to four digits"},
+		{Name: "legs[0].originAirport", Reason: "must be a three letter IATA airport code"},
+		{Name: "legs[1].date", Reason: "is required"},
+		{Name: "legs[1].flightNumber", Reason: "must be an IATA airline code followed by a flight number of up to four digits"},
+	}, newValidationError(err).Params)
+
+	err = v.Struct(request.Flight{})
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{{Name: "legs", Reason: "must have at least 1 elements"}}, newValidationError(err).Params)
+}
+
+func TestNormalizeFlight(t *testing.T) {
+	v := newValidator()
+
+	var flight request.Flight
+	require.NoError(t, json.Unmarshal([]byte(`{"legs":[{"date":"`+civil.DateOf(time.Now()).String()+`","flightNumber":" ek412 ","originAirport":"syd"}]}`), &flight))
+	assert.Equal(t, "EK412", flight.Legs[0].FlightNumber)
+	assert.Equal(t, "SYD", *flight.Legs[0].OriginAirport)
+	assert.NoError(t, v.Struct(flight))
+}
+
+func TestValidateDirections(t *testing.T) {
+	v := newValidator()
+
+	valid := request.Directions{
+		Start:              entity.Location{Latitude: 52.5, Longitude: 13.4},
+		End:                entity.Location{Latitude: 48.1, Longitude: 11.6},
+		TransportationType: entity.CAR,
+	}
+	assert.NoError(t, v.Struct(valid))
+
+	invalid := valid
+	invalid.Waypoints = []entity.Location{{Latitude: 91, Longitude: 13.4}}
+	invalid.End.Longitude = -181
+	invalid.TransportationType = "PLANE"
+	err := v.Struct(invalid)
+	require.Error(t, err)
+
+	assert.ElementsMatch(t, []response.InvalidParam{
+		{Name: "waypoints[0].latitude", Reason: "must be between -90 and 90"},
+		{Name: "end.longitude", Reason: "must be between -180 and 180"},
+		{Name: "transportationType", Reason: "must be one of FLIGHT TRAIN BUS CAR FERRY BOAT BIKE HIKE OTHER"},
+	}, newValidationError(err).Params)
+
+	err = v.Struct(request.Directions{End: valid.End, TransportationType: entity.CAR})
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{{Name: "start", Reason: "is required"}}, newValidationError(err).Params)
+}
+
+func TestValidatePois(t *testing.T) {
+	v := newValidator()
+
+	valid := request.Pois{
+		Location:    entity.Location{Latitude: 52.5, Longitude: 13.4},
+		BoundingBox: []float64{13.3, 52.4, 13.5, 52.6},
+	}
+	assert.NoError(t, v.Struct(valid))
+
+	for _, box := range [][]float64{
+		{13.3, 52.4, 13.5},
+		{13.5, 52.4, 13.3, 52.6},
+		{13.3, 52.6, 13.5, 52.4},
+		{-181, 52.4, 13.5, 52.6},
+		{13.3, 52.4, 13.5, 91},
+	} {
+		err := v.Struct(request.Pois{Location: valid.Location, BoundingBox: box})
+		require.Error(t, err, box)
+		assert.Equal(t, "boundingBox", newValidationError(err).Params[0].Name)
+	}
+
+	err := v.Struct(request.Pois{})
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{{Name: "location", Reason: "is required"}}, newValidationError(err).Params)
+}
+
+func TestValidateTrain(t *testing.T) {
+	v := newValidator()
+	train := request.Train{
+		FromStationID: "8011113",
+		ToStationID:   "Berlin",
+		TrainNumbers:  []string{"ICE707"},
+		DepartureDate: civil.DateOf(time.Now()),
+	}
+
+	err := v.Struct(train)
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{{Name: "toStationId", Reason: "must be an IBNR station ID of seven digits"}}, newValidationError(err).Params)
+}
+
+func TestValidateBatch(t *testing.T) {
+	v := newValidator()
+	trip := &request.TrainTrip{TrainNumber: "ICE 707", DepartureDate: civil.DateOf(time.Now())}
+
+	assert.NoError(t, v.Struct(request.BatchItem{ID: "a", TrainTrip: trip}))
+
+	err := v.Struct(request.BatchItem{ID: "a", TrainTrip: trip, Flight: &request.Flight{Legs: []request.FlightLeg{{}}}})
+	require.Error(t, err)
+	assert.Contains(t, newValidationError(err).Params, response.InvalidParam{Name: "flight", Reason: "must not be combined with train, trainTrip, trainTripSection"})
+
+	err = v.Struct(request.Batch{Items: []request.BatchItem{{ID: "a"}, {ID: "a"}}})
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{{Name: "items", Reason: "must not contain duplicate ids"}}, newValidationError(err).Params)
+}
+
+func TestValidateWatch(t *testing.T) {
+	v := newValidator()
+	flight := &request.FlightLeg{Date: civil.DateOf(time.Now()), FlightNumber: "EK412"}
+
+	err := v.Struct(request.Watch{SubscriberKey: "user", Flight: flight})
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{
+		{Name: "webhookUrl", Reason: "is required without pushSubscription"},
+		{Name: "pushSubscription", Reason: "is required without webhookUrl"},
+	}, newValidationError(err).Params)
+
+	subscription := &entity.PushSubscription{Endpoint: "http://push.example.net/abc", Keys: entity.PushSubscriptionKeys{P256dh: "key", Auth: "secret"}}
+	err = v.Struct(request.Watch{SubscriberKey: "user", Flight: flight, PushSubscription: subscription})
+	require.Error(t, err)
+	assert.Equal(t, []response.InvalidParam{{Name: "pushSubscription.endpoint", Reason: "must be an HTTPS URL"}}, newValidationError(err).Params)
+}
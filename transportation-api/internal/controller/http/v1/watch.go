@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type WatchesV1 struct {
+	uc  usecase.Watches
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Watch flight or train
+// @Description Flights only change with their scheduled times or aircraft, as their schedules carry no gates or realtime delays. Changes are posted as signed watch.changed events to the webhook URL until the journey arrived, followed by a watch.expired event. Webhook URLs must use https and point to a public address, and are only accepted if a webhook secret is configured, push subscriptions only if a VAPID key is.
+// @ID          createWatch
+// @Tags  	    watches
+// @Accept      json
+// @Produce     json
+// @Param       request body request.Watch true "watch"
+// @Success     201 {object} entity.Watch
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Failure     501 {object} response.Error
+// @Router      /watches [post]
+func (r *WatchesV1) createWatch(ctx *fiber.Ctx) error {
+	body, err := ParseAndValidateRequestBody[request.Watch](ctx, r.v)
+	if err != nil {
+		return err
+	}
+
+	watch, err := r.uc.CreateWatch(ctx.UserContext(), *body)
+	if err != nil {
+		return fmt.Errorf("create watch: %w", err)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(watch)
+}
+
+// @Summary     List watches
+// @ID          listWatches
+// @Tags  	    watches
+// @Produce     json
+// @Param       subscriberKey query string true "subscriber key"
+// @Success     200 {array} entity.Watch
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /watches [get]
+func (r *WatchesV1) listWatches(ctx *fiber.Ctx) error {
+	subscriberKey := ctx.Query("subscriberKey")
+	if subscriberKey == "" {
+		return &response.ValidationError{
+			Message: "invalid query",
+			Params:  []response.InvalidParam{{Name: "subscriberKey", Reason: "is required"}},
+		}
+	}
+
+	watches, err := r.uc.ListWatches(ctx.UserContext(), subscriberKey)
+	if err != nil {
+		return fmt.Errorf("list watches: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(watches)
+}
+
+// @Summary     Retrieve watch
+// @ID          retrieveWatch
+// @Tags  	    watches
+// @Produce     json
+// @Param       id path string true "watch ID"
+// @Success     200 {object} entity.Watch
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /watches/{id} [get]
+func (r *WatchesV1) retrieveWatch(ctx *fiber.Ctx) error {
+	watch, err := r.uc.RetrieveWatch(ctx.UserContext(), ctx.Params("id"))
+	if err != nil {
+		return fmt.Errorf("retrieve watch: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(watch)
+}
+
+// @Summary     Delete watch
+// @ID          deleteWatch
+// @Tags  	    watches
+// @Param       id path string true "watch ID"
+// @Success     204
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Router      /watches/{id} [delete]
+func (r *WatchesV1) deleteWatch(ctx *fiber.Ctx) error {
+	if err := r.uc.DeleteWatch(ctx.UserContext(), ctx.Params("id")); err != nil {
+		return fmt.Errorf("delete watch: %w", err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
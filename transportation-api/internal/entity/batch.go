@@ -0,0 +1,11 @@
+package entity
+
+// BatchResult is the result of a single batch item. The result matching the
+// item's request is set, unless the item failed with Err.
+type BatchResult struct {
+	ID        string     `json:"id"`
+	Flight    *Flight    `json:"flight,omitempty"    validate:"optional"`
+	Train     *Train     `json:"train,omitempty"     validate:"optional"`
+	TrainTrip *TrainTrip `json:"trainTrip,omitempty" validate:"optional"`
+	Err       error      `json:"-"`
+}
@@ -0,0 +1,58 @@
+package entity
+
+import "github.com/paulmach/orb/geojson"
+
+type RouteStep struct {
+	Instruction       string  `json:"instruction"       example:"Turn left onto Unter den Linden"`
+	Name              string  `json:"name"`
+	DistanceInMeters  float64 `json:"distanceInMeters"`
+	DurationInSeconds float64 `json:"durationInSeconds"`
+}
+
+type ElevationPoint struct {
+	DistanceInMeters  float64 `json:"distanceInMeters"`
+	ElevationInMeters float64 `json:"elevationInMeters"`
+}
+
+// Route summarises one alternative of Directions.GeoJson. Elevation data is
+// only available if the router provides it.
+type Route struct {
+	DistanceInMeters  float64          `json:"distanceInMeters"`
+	DurationInSeconds float64          `json:"durationInSeconds"`
+	AscentInMeters    *float64         `json:"ascentInMeters"    extensions:"nullable"`
+	DescentInMeters   *float64         `json:"descentInMeters"   extensions:"nullable"`
+	ElevationProfile  []ElevationPoint `json:"elevationProfile"`
+	Steps             []RouteStep      `json:"steps"`
+}
+
+type Directions struct {
+	Routes  []Route                    `json:"routes"`
+	GeoJson *geojson.FeatureCollection `json:"geoJson"`
+}
+
+// RoadTripStage is the drive of a single day. Overnight is the suggested
+// town to stay at the end of the stage and is missing on the last day.
+type RoadTripStage struct {
+	Day               int              `json:"day"                 example:"1"`
+	Start             Location         `json:"start"`
+	End               Location         `json:"end"`
+	Overnight         *GeocodePlace    `json:"overnight,omitempty" validate:"optional"`
+	DistanceInMeters  float64          `json:"distanceInMeters"`
+	DurationInSeconds float64          `json:"durationInSeconds"`
+	GeoJson           *geojson.Feature `json:"geoJson"`
+}
+
+type RoadTrip struct {
+	DistanceInMeters  float64         `json:"distanceInMeters"`
+	DurationInSeconds float64         `json:"durationInSeconds"`
+	Stages            []RoadTripStage `json:"stages"`
+}
+
+// Matrix contains the durations and distances from each location (row) to
+// each other location (column), or null if there is no route. Order is the
+// suggested sequence of location indices if it was requested.
+type Matrix struct {
+	DurationsInSeconds [][]*float64 `json:"durationsInSeconds"`
+	DistancesInMeters  [][]*float64 `json:"distancesInMeters"`
+	Order              []int        `json:"order"              extensions:"nullable" example:"0,2,1"`
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"strings"
+)
+
+// ErrorKind classifies errors, so they can be mapped to HTTP status codes.
+// Kinds are matched with errors.Is, e.g. errors.Is(err, entity.ErrNotFound).
+type ErrorKind string
+
+const (
+	ErrNotFound            ErrorKind = "NOT_FOUND"
+	ErrAmbiguous           ErrorKind = "AMBIGUOUS"
+	ErrInvalidInput        ErrorKind = "INVALID_INPUT"
+	ErrUpstreamUnavailable ErrorKind = "UPSTREAM_UNAVAILABLE"
+	ErrRateLimited         ErrorKind = "RATE_LIMITED"
+	ErrUpstreamTimeout     ErrorKind = "UPSTREAM_TIMEOUT"
+	// ErrSubscriptionExpired is returned for push subscriptions which the
+	// push service no longer accepts. They should be removed.
+	ErrSubscriptionExpired ErrorKind = "SUBSCRIPTION_EXPIRED"
+	// ErrNotConfigured is returned for features which the server is not
+	// set up for.
+	ErrNotConfigured ErrorKind = "NOT_CONFIGURED"
+)
+
+func (k ErrorKind) Error() string {
+	return strings.ToLower(strings.ReplaceAll(string(k), "_", " "))
+}
+
+// Error is a domain error. Its message is meant for clients, while the
+// wrapped error carries the details for the logs.
+type Error struct {
+	Kind    ErrorKind
+	Message string
+	Err     error
+}
+
+func NewError(kind ErrorKind, message string) *Error {
+	return &Error{Kind: kind, Message: message}
+}
+
+func WrapError(kind ErrorKind, message string, err error) *Error {
+	return &Error{Kind: kind, Message: message, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
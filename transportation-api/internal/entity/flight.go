@@ -52,3 +52,7 @@ type AmbiguousFlightChoice struct {
 func (e ErrAmbiguousFlightRequest) Error() string {
 	return fmt.Sprint(map[string][]AmbiguousFlightChoice(e))
 }
+
+func (e ErrAmbiguousFlightRequest) Is(target error) bool {
+	return target == ErrAmbiguous
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type LiveEventType string
+
+const (
+	// LiveSnapshot carries the complete state of a subject, sent when it is
+	// first known and whenever missed changes can't be replayed.
+	LiveSnapshot LiveEventType = "snapshot"
+	LiveChanged  LiveEventType = "changed"
+	// LiveFailed is sent once if the subject can't be found.
+	LiveFailed LiveEventType = "failed"
+)
+
+// LiveSubject is a flight or train journey followed by live streams. The
+// key identifies the subject, so streams of the same key share their polls.
+type LiveSubject struct {
+	Key    string
+	Flight *WatchedFlight
+	Train  *WatchedTrain
+}
+
+type LiveEvent struct {
+	ID         string        `json:"id"         example:"1760881379.42"`
+	Type       LiveEventType `json:"type"       example:"changed"`
+	Subject    string        `json:"subject"    example:"flight:EK412:2026-01-30"`
+	OccurredAt time.Time     `json:"occurredAt"`
+	Changes    []WatchChange `json:"changes"`
+	Legs       []WatchLeg    `json:"legs"`
+	Error      string        `json:"error,omitempty"`
+}
@@ -1,8 +1,8 @@
 package entity
 
 type Location struct {
-	Latitude  float32 `json:"latitude"`
-	Longitude float32 `json:"longitude"`
+	Latitude  float32 `json:"latitude"  validate:"latitude"`
+	Longitude float32 `json:"longitude" validate:"longitude"`
 }
 
 type GeocodeLocation struct {
@@ -10,3 +10,39 @@ type GeocodeLocation struct {
 	Latitude  float32 `json:"latitude"`
 	Longitude float32 `json:"longitude"`
 }
+
+type GeocodeAddress struct {
+	CountryCode *string `json:"countryCode" extensions:"nullable" example:"DE"`
+	Country     *string `json:"country"     extensions:"nullable"`
+	Region      *string `json:"region"      extensions:"nullable"`
+	Locality    *string `json:"locality"    extensions:"nullable"`
+	PostalCode  *string `json:"postalCode"  extensions:"nullable"`
+	Street      *string `json:"street"      extensions:"nullable"`
+	HouseNumber *string `json:"houseNumber" extensions:"nullable"`
+}
+
+// GeocodePlace is a single geocoding candidate. The bounding box is given as
+// [minLongitude, minLatitude, maxLongitude, maxLatitude]. The distance is
+// only set for reverse geocoding.
+type GeocodePlace struct {
+	Label                string         `json:"label"`
+	Name                 string         `json:"name"`
+	Layer                string         `json:"layer"                example:"venue"`
+	Latitude             float32        `json:"latitude"`
+	Longitude            float32        `json:"longitude"`
+	Address              GeocodeAddress `json:"address"`
+	BoundingBox          []float64      `json:"boundingBox"          extensions:"nullable"`
+	DistanceInKilometers *float64       `json:"distanceInKilometers" extensions:"nullable"`
+}
+
+// Poi is a point of interest near a location. The category is one of
+// restaurant, supermarket, pharmacy or sight.
+type Poi struct {
+	ID               string  `json:"id"               example:"node/240109189"`
+	Name             string  `json:"name"`
+	Category         string  `json:"category"         example:"restaurant"`
+	Latitude         float32 `json:"latitude"`
+	Longitude        float32 `json:"longitude"`
+	OpeningHours     *string `json:"openingHours"     extensions:"nullable" example:"Mo-Fr 11:00-22:00"`
+	DistanceInMeters float64 `json:"distanceInMeters"`
+}
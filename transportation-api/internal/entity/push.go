@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// PushSubscription is a Web Push subscription as returned by
+// PushManager.subscribe() of the browser.
+type PushSubscription struct {
+	Endpoint string               `json:"endpoint" validate:"required,https_url,max=2048" example:"https://fcm.googleapis.com/fcm/send/dpH5..."`
+	Keys     PushSubscriptionKeys `json:"keys"     validate:"required"`
+}
+
+type PushSubscriptionKeys struct {
+	// P256dh is the public key of the user agent, Auth its authentication
+	// secret, both encoded as unpadded base64url.
+	P256dh string `json:"p256dh" validate:"required,max=128" example:"BNcRdreALRFXTkOOUHK1EtK2wtaz5Ry4YfYCA_0QTpQtUbVlUls0VJXg7A8u-Ts1XbjhazAkj7I99e8QcYP7DkM"`
+	Auth   string `json:"auth"   validate:"required,max=64"  example:"tBHItJI5svbpez7KI4CCXg"`
+}
+
+// MaxPushPayloadSize is the most push services accept after encryption.
+const MaxPushPayloadSize = 3993
+
+type PushUrgency string
+
+const (
+	PushVeryLow PushUrgency = "very-low"
+	PushLow     PushUrgency = "low"
+	PushNormal  PushUrgency = "normal"
+	PushHigh    PushUrgency = "high"
+)
+
+// PushMessage is delivered by the push service within the TTL, or dropped.
+// Messages of the same topic replace each other while undelivered.
+type PushMessage struct {
+	Payload []byte
+	// TTL defaults to the configured one if nil
+	TTL     *time.Duration
+	Urgency PushUrgency
+	Topic   string
+}
+
+type VapidKey struct {
+	// PublicKey is the application server key for PushManager.subscribe(),
+	// encoded as unpadded base64url.
+	PublicKey string `json:"publicKey" example:"BEl62iUYgUivxIkv69yViEuiBIa-Ib9-SkvMeAtA3LFgDzkrxZJjSgSnfckjBJuBkr3qBUYIHBQFLXYp5Nksh8U"`
+}
@@ -0,0 +1,242 @@
+package entity
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+type StopTimeEvent struct {
+	Delay *int32     `json:"delay" extensions:"nullable"`
+	Time  *time.Time `json:"time"  extensions:"nullable"`
+}
+
+type StopTimeUpdate struct {
+	StopSequence *uint32       `json:"stopSequence" extensions:"nullable"`
+	StopID       string        `json:"stopId"`
+	Arrival      StopTimeEvent `json:"arrival"`
+	Departure    StopTimeEvent `json:"departure"`
+	Skipped      bool          `json:"skipped"`
+}
+
+type TripUpdate struct {
+	TripID          string           `json:"tripId"`
+	StartDate       *civil.Date      `json:"startDate" extensions:"nullable"`
+	Cancelled       bool             `json:"cancelled"`
+	Delay           *int32           `json:"delay"     extensions:"nullable"`
+	StopTimeUpdates []StopTimeUpdate `json:"stopTimeUpdates"`
+	Timestamp       time.Time        `json:"timestamp"`
+}
+
+type VehiclePosition struct {
+	TripID    string    `json:"tripId"`
+	Location  Location  `json:"location"`
+	Bearing   *float32  `json:"bearing" extensions:"nullable"`
+	StopID    *string   `json:"stopId"  extensions:"nullable"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type AlertPeriod struct {
+	Start *time.Time `json:"start" extensions:"nullable"`
+	End   *time.Time `json:"end"   extensions:"nullable"`
+}
+
+type ServiceAlert struct {
+	ID            string        `json:"id"`
+	Feed          string        `json:"feed"`
+	Cause         string        `json:"cause"       example:"STRIKE"`
+	Effect        string        `json:"effect"      example:"SIGNIFICANT_DELAYS"`
+	Header        string        `json:"header"`
+	Description   string        `json:"description"`
+	URL           *string       `json:"url"         extensions:"nullable"`
+	ActivePeriods []AlertPeriod `json:"activePeriods"`
+	RouteIDs      []string      `json:"routeIds"`
+	StopIDs       []string      `json:"stopIds"`
+	TripIDs       []string      `json:"tripIds"`
+}
+
+// IsActive reports whether the alert applies at the given time. Alerts
+// without active periods are always active.
+func (a ServiceAlert) IsActive(at time.Time) bool {
+	if len(a.ActivePeriods) == 0 {
+		return true
+	}
+	for _, period := range a.ActivePeriods {
+		if (period.Start == nil || !at.Before(*period.Start)) && (period.End == nil || at.Before(*period.End)) {
+			return true
+		}
+	}
+	return false
+}
+
+// realtimeStop abstracts over train and transit stopovers so that trip
+// updates can be applied to both in the same way.
+type realtimeStop struct {
+	stopID            string
+	sequence          *uint32
+	arrival           *civil.DateTime
+	departure         *civil.DateTime
+	realtimeArrival   **civil.DateTime
+	realtimeDeparture **civil.DateTime
+	cancelled         *bool
+}
+
+// applyStopTimeUpdates sets the realtime times of the stops. A delay is
+// propagated to the following stops until the next stop time update, as
+// required by the GTFS-Realtime spec, including delays reported for stops of
+// the trip before the first one. Absolute times are interpreted in location,
+// if given.
+func applyStopTimeUpdates(stops []realtimeStop, update TripUpdate, location *time.Location) {
+	updatesByStop := map[string]StopTimeUpdate{}
+	for _, stopTimeUpdate := range update.StopTimeUpdates {
+		updatesByStop[stopTimeUpdate.StopID] = stopTimeUpdate
+	}
+
+	delay := update.Delay
+	if d := upstreamDelay(stops, update.StopTimeUpdates); d != nil {
+		delay = d
+	}
+	for _, stop := range stops {
+		arrivalDelay, departureDelay := delay, delay
+
+		if stopTimeUpdate, ok := updatesByStop[stop.stopID]; ok {
+			if stopTimeUpdate.Skipped && stop.cancelled != nil {
+				*stop.cancelled = true
+			}
+			if d := eventDelay(stopTimeUpdate.Arrival, stop.arrival, location); d != nil {
+				arrivalDelay, departureDelay = d, d
+			}
+			if d := eventDelay(stopTimeUpdate.Departure, stop.departure, location); d != nil {
+				departureDelay = d
+			}
+			delay = departureDelay
+		}
+
+		if stop.arrival != nil && arrivalDelay != nil {
+			*stop.realtimeArrival = addSeconds(*stop.arrival, *arrivalDelay)
+		}
+		if stop.departure != nil && departureDelay != nil {
+			*stop.realtimeDeparture = addSeconds(*stop.departure, *departureDelay)
+		}
+	}
+}
+
+// upstreamDelay returns the delay of the last stop time update before the
+// first stop. Stop time updates are ordered by stop sequence, so updates
+// without a sequence are only known to be upstream if they precede one for a
+// stop at or after the first stop.
+func upstreamDelay(stops []realtimeStop, updates []StopTimeUpdate) *int32 {
+	if len(stops) == 0 {
+		return nil
+	}
+	first := stops[0].sequence
+	isStop := map[string]bool{}
+	for _, stop := range stops {
+		isStop[stop.stopID] = true
+	}
+
+	end := -1
+	for i, stopTimeUpdate := range updates {
+		if stopTimeUpdate.StopSequence != nil && first != nil {
+			if *stopTimeUpdate.StopSequence >= *first {
+				end = i
+				break
+			}
+		} else if isStop[stopTimeUpdate.StopID] {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		if first == nil {
+			return nil
+		}
+		// trailing updates without a sequence may be downstream
+		end = len(updates)
+		for end > 0 && updates[end-1].StopSequence == nil {
+			end--
+		}
+	}
+
+	var delay *int32
+	for _, stopTimeUpdate := range updates[:end] {
+		if stopTimeUpdate.Departure.Delay != nil {
+			delay = stopTimeUpdate.Departure.Delay
+		} else if stopTimeUpdate.Arrival.Delay != nil {
+			delay = stopTimeUpdate.Arrival.Delay
+		}
+	}
+	return delay
+}
+
+func eventDelay(event StopTimeEvent, scheduled *civil.DateTime, location *time.Location) *int32 {
+	if event.Delay != nil {
+		return event.Delay
+	}
+	if event.Time == nil || scheduled == nil || location == nil {
+		return nil
+	}
+	delay := int32(event.Time.Sub(scheduled.In(location)).Seconds())
+	return &delay
+}
+
+func addSeconds(dateTime civil.DateTime, seconds int32) *civil.DateTime {
+	shifted := civil.DateTimeOf(dateTime.In(time.UTC).Add(time.Duration(seconds) * time.Second))
+	return &shifted
+}
+
+// ApplyTripUpdate sets the realtime state of a leg resolved from a GTFS feed.
+func (l *TransitLeg) ApplyTripUpdate(update TripUpdate) {
+	var location *time.Location
+	if l.Timezone != "" {
+		location, _ = time.LoadLocation(l.Timezone)
+	}
+
+	stops := make([]realtimeStop, len(l.Stopovers))
+	for i := range l.Stopovers {
+		stopover := &l.Stopovers[i]
+		stops[i] = realtimeStop{
+			stopID:            stopover.Stop.ID,
+			sequence:          &stopover.StopSequence,
+			arrival:           &stopover.ArrivalDateTime,
+			departure:         &stopover.DepartureDateTime,
+			realtimeArrival:   &stopover.RealtimeArrivalDateTime,
+			realtimeDeparture: &stopover.RealtimeDepartureDateTime,
+			cancelled:         &stopover.Cancelled,
+		}
+	}
+	applyStopTimeUpdates(stops, update, location)
+
+	l.Cancelled = l.Cancelled || update.Cancelled
+	if len(l.Stopovers) > 0 {
+		l.RealtimeDepartureDateTime = l.Stopovers[0].RealtimeDepartureDateTime
+		l.RealtimeArrivalDateTime = l.Stopovers[len(l.Stopovers)-1].RealtimeArrivalDateTime
+	}
+}
+
+// ApplyTripUpdate sets the realtime state of a train leg. Timestamps of
+// db-vendo come without timezone, so only delays are applied.
+func (l *TrainLeg) ApplyTripUpdate(update TripUpdate) {
+	stops := make([]realtimeStop, len(l.Stopovers))
+	for i := range l.Stopovers {
+		stopover := &l.Stopovers[i]
+		stops[i] = realtimeStop{
+			stopID:            stopover.Station.ID,
+			arrival:           stopover.ArrivalDateTime,
+			departure:         stopover.DepartureDateTime,
+			realtimeArrival:   &stopover.RealtimeArrivalDateTime,
+			realtimeDeparture: &stopover.RealtimeDepartureDateTime,
+			cancelled:         &stopover.Cancelled,
+		}
+	}
+	applyStopTimeUpdates(stops, update, nil)
+
+	l.Cancelled = l.Cancelled || update.Cancelled
+	if len(l.Stopovers) > 0 {
+		l.RealtimeDepartureDateTime = l.Stopovers[0].RealtimeDepartureDateTime
+		l.RealtimeArrivalDateTime = l.Stopovers[len(l.Stopovers)-1].RealtimeArrivalDateTime
+	} else if update.Delay != nil {
+		l.RealtimeDepartureDateTime = addSeconds(l.DepartureDateTime, *update.Delay)
+		l.RealtimeArrivalDateTime = addSeconds(l.ArrivalDateTime, *update.Delay)
+	}
+}
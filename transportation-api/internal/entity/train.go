@@ -12,17 +12,69 @@ type TrainStation struct {
 }
 
 type TrainLeg struct {
-	Origin            TrainStation   `json:"origin"`
-	Destination       TrainStation   `json:"destination"`
-	DepartureDateTime civil.DateTime `json:"departureDateTime"`
-	ArrivalDateTime   civil.DateTime `json:"arrivalDateTime"`
-	DurationInMinutes int32          `json:"durationInMinutes"`
-	LineName          string         `json:"lineName"`
-	OperatorName      string         `json:"operatorName"`
+	TripID                    string          `json:"tripId"`
+	Origin                    TrainStation    `json:"origin"`
+	Destination               TrainStation    `json:"destination"`
+	DepartureDateTime         civil.DateTime  `json:"departureDateTime"`
+	RealtimeDepartureDateTime *civil.DateTime `json:"realtimeDepartureDateTime" extensions:"nullable"`
+	ArrivalDateTime           civil.DateTime  `json:"arrivalDateTime"`
+	RealtimeArrivalDateTime   *civil.DateTime `json:"realtimeArrivalDateTime"   extensions:"nullable"`
+	Cancelled                 bool            `json:"cancelled"`
+	DurationInMinutes         int32           `json:"durationInMinutes"`
+	LineName                  string          `json:"lineName"`
+	OperatorName              string          `json:"operatorName"`
+	DeparturePlatform         *string         `json:"departurePlatform" extensions:"nullable"`
+	ArrivalPlatform           *string         `json:"arrivalPlatform"   extensions:"nullable"`
+	Stopovers                 []TrainStopover `json:"stopovers"`
+}
+
+type TrainTransferType string
+
+const (
+	WALKING  TrainTransferType = "WALKING"
+	TRANSFER TrainTransferType = "TRANSFER"
+)
+
+type TrainTransfer struct {
+	Type              TrainTransferType `json:"type"`
+	Origin            TrainStation      `json:"origin"`
+	Destination       TrainStation      `json:"destination"`
+	DepartureDateTime civil.DateTime    `json:"departureDateTime"`
+	ArrivalDateTime   civil.DateTime    `json:"arrivalDateTime"`
+	DurationInMinutes int32             `json:"durationInMinutes"`
+	DistanceInMeters  *int32            `json:"distanceInMeters" extensions:"nullable"`
+	Geometry          *geojson.Geometry `json:"geometry"         extensions:"nullable"`
 }
 
 type Train struct {
 	RefreshToken string                     `json:"refreshToken"`
 	Legs         []TrainLeg                 `json:"legs"`
+	Transfers    []TrainTransfer            `json:"transfers"`
 	GeoJson      *geojson.FeatureCollection `json:"geoJson"`
 }
+
+// TrainPolyline is the path of a leg or transfer between two stations.
+// Polylines of legs may be empty if the provider has none.
+type TrainPolyline struct {
+	OriginID      string                    `json:"originId"`
+	DestinationID string                    `json:"destinationId"`
+	Polyline      geojson.FeatureCollection `json:"polyline"`
+}
+
+type TrainStopover struct {
+	Station                   TrainStation    `json:"station"`
+	ArrivalDateTime           *civil.DateTime `json:"arrivalDateTime"           extensions:"nullable"`
+	RealtimeArrivalDateTime   *civil.DateTime `json:"realtimeArrivalDateTime"   extensions:"nullable"`
+	DepartureDateTime         *civil.DateTime `json:"departureDateTime"         extensions:"nullable"`
+	RealtimeDepartureDateTime *civil.DateTime `json:"realtimeDepartureDateTime" extensions:"nullable"`
+	Platform                  *string         `json:"platform"                  extensions:"nullable"`
+	Cancelled                 bool            `json:"cancelled"`
+}
+
+type TrainTrip struct {
+	ID           string                     `json:"id"`
+	LineName     string                     `json:"lineName"`
+	OperatorName string                     `json:"operatorName"`
+	Stopovers    []TrainStopover            `json:"stopovers"`
+	Polyline     *geojson.FeatureCollection `json:"-"`
+}
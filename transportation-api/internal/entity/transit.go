@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb/geojson"
+)
+
+type TransitStop struct {
+	ID       string   `json:"id"`
+	Feed     string   `json:"feed"`
+	Name     string   `json:"name"`
+	Location Location `json:"location"`
+}
+
+type TransitStopover struct {
+	Stop                      TransitStop     `json:"stop"`
+	StopSequence              uint32          `json:"stopSequence"`
+	ArrivalDateTime           civil.DateTime  `json:"arrivalDateTime"`
+	RealtimeArrivalDateTime   *civil.DateTime `json:"realtimeArrivalDateTime"   extensions:"nullable"`
+	DepartureDateTime         civil.DateTime  `json:"departureDateTime"`
+	RealtimeDepartureDateTime *civil.DateTime `json:"realtimeDepartureDateTime" extensions:"nullable"`
+	Cancelled                 bool            `json:"cancelled"`
+}
+
+type TransitLeg struct {
+	Feed                      string             `json:"feed"`
+	TripID                    string             `json:"tripId"`
+	RouteID                   string             `json:"routeId"`
+	Type                      TransportationType `json:"type"`
+	Origin                    TransitStop        `json:"origin"`
+	Destination               TransitStop        `json:"destination"`
+	DepartureDateTime         civil.DateTime     `json:"departureDateTime"`
+	RealtimeDepartureDateTime *civil.DateTime    `json:"realtimeDepartureDateTime" extensions:"nullable"`
+	ArrivalDateTime           civil.DateTime     `json:"arrivalDateTime"`
+	RealtimeArrivalDateTime   *civil.DateTime    `json:"realtimeArrivalDateTime"   extensions:"nullable"`
+	DurationInMinutes         int32              `json:"durationInMinutes"`
+	Timezone                  string             `json:"timezone"`
+	RouteName                 string             `json:"routeName"`
+	Headsign                  string             `json:"headsign"`
+	AgencyName                string             `json:"agencyName"`
+	Cancelled                 bool               `json:"cancelled"`
+	Vehicle                   *VehiclePosition   `json:"vehicle,omitempty"          validate:"optional"`
+	Stopovers                 []TransitStopover  `json:"stopovers"`
+}
+
+type Transit struct {
+	Legs    []TransitLeg               `json:"legs"`
+	GeoJson *geojson.FeatureCollection `json:"geoJson"`
+}
@@ -1,5 +1,7 @@
 package entity
 
+import "slices"
+
 type TransportationType string
 
 const (
@@ -14,6 +16,15 @@ const (
 	OTHER  TransportationType = "OTHER"
 )
 
+// TransportationTypes lists all types, in the order they are documented.
+var TransportationTypes = []TransportationType{
+	FLIGHT, TRAIN, BUS, CAR, FERRY, BOAT, BIKE, HIKE, OTHER,
+}
+
+func (t TransportationType) IsValid() bool {
+	return slices.Contains(TransportationTypes, t)
+}
+
 func (t TransportationType) String() string {
 	return string(t)
 }
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+type WatchEventType string
+
+const (
+	WatchChanged WatchEventType = "watch.changed"
+	WatchExpired WatchEventType = "watch.expired"
+)
+
+// Watch monitors a flight leg or a train journey for schedule changes, which
+// are sent to the webhook URL and the push subscription. Legs are the state
+// last delivered.
+type Watch struct {
+	ID               string            `json:"id"`
+	SubscriberKey    string            `json:"subscriberKey"`
+	WebhookURL       string            `json:"webhookUrl,omitempty"`
+	PushSubscription *PushSubscription `json:"pushSubscription,omitempty" validate:"optional"`
+	Flight           *WatchedFlight    `json:"flight,omitempty"           validate:"optional"`
+	Train            *WatchedTrain     `json:"train,omitempty"            validate:"optional"`
+	Legs             []WatchLeg        `json:"legs"`
+	CreatedAt        time.Time         `json:"createdAt"`
+	CheckedAt        time.Time         `json:"checkedAt"`
+	NextCheckAt      time.Time         `json:"nextCheckAt"`
+}
+
+type WatchedFlight struct {
+	Date          civil.Date `json:"date"          example:"2026-01-30"`
+	FlightNumber  string     `json:"flightNumber"  example:"EK412"`
+	OriginAirport *string    `json:"originAirport" extensions:"nullable" example:"SYD"`
+}
+
+type WatchedTrain struct {
+	FromStationID string     `json:"fromStationId" example:"8011113"`
+	ToStationID   string     `json:"toStationId"   example:"8000261"`
+	TrainNumbers  []string   `json:"trainNumbers"  example:"ICE707"`
+	DepartureDate civil.Date `json:"departureDate" example:"2025-09-20"`
+	ViaStationID  *string    `json:"viaStationId"  extensions:"nullable" example:"8596008"`
+	Provider      *string    `json:"provider"      extensions:"nullable" example:"oebb"`
+}
+
+// WatchLeg is the monitored state of a single flight or train leg.
+type WatchLeg struct {
+	Origin                    string          `json:"origin"`
+	Destination               string          `json:"destination"`
+	DepartureDateTime         civil.DateTime  `json:"departureDateTime"`
+	RealtimeDepartureDateTime *civil.DateTime `json:"realtimeDepartureDateTime" extensions:"nullable"`
+	ArrivalDateTime           civil.DateTime  `json:"arrivalDateTime"`
+	RealtimeArrivalDateTime   *civil.DateTime `json:"realtimeArrivalDateTime"   extensions:"nullable"`
+	DeparturePlatform         *string         `json:"departurePlatform"         extensions:"nullable"`
+	ArrivalPlatform           *string         `json:"arrivalPlatform"           extensions:"nullable"`
+	Aircraft                  *string         `json:"aircraft"                  extensions:"nullable"`
+	Cancelled                 bool            `json:"cancelled"`
+}
+
+type WatchChange struct {
+	Leg      int     `json:"leg"`
+	Field    string  `json:"field"    example:"realtimeDepartureDateTime"`
+	Previous *string `json:"previous" extensions:"nullable"`
+	Current  *string `json:"current"  extensions:"nullable"`
+}
+
+// WatchEvent is posted to the webhook of a watch. The ID is the same for
+// all delivery attempts of an event.
+type WatchEvent struct {
+	ID            string         `json:"id"`
+	Type          WatchEventType `json:"type"`
+	WatchID       string         `json:"watchId"`
+	SubscriberKey string         `json:"subscriberKey"`
+	OccurredAt    time.Time      `json:"occurredAt"`
+	Changes       []WatchChange  `json:"changes"`
+	Legs          []WatchLeg     `json:"legs"`
+}
@@ -7,10 +7,10 @@ import (
 	"kompass/config"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/civil"
-	"github.com/gofiber/fiber/v2"
 	goiso8601duration "github.com/xnacly/go-iso8601-duration"
 )
 
@@ -19,6 +19,11 @@ type AmadeusWebAPI struct {
 	apiKey     string
 	apiSecret  string
 	iataLookup repo.IataLookup
+
+	// the access token is shared by all requests until shortly before it expires
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
 }
 
 func New(config config.WebApi, iataLookup repo.IataLookup) *AmadeusWebAPI {
@@ -52,7 +57,7 @@ func (a *AmadeusWebAPI) RetrieveFlightLeg(ctx context.Context, date civil.Date,
 		}
 	}
 
-	return entity.FlightLeg{}, fiber.NewError(fiber.StatusNotFound, "no matching flight found")
+	return entity.FlightLeg{}, entity.NewError(entity.ErrNotFound, "no matching flight found")
 }
 
 type legOfDatedFlight struct {
@@ -125,7 +130,7 @@ func determineTimestamps(
 	origin, originFound := findFlightPointByIata(flightLeg.DatedFlight, flightLeg.Leg.BoardPointIataCode)
 	destination, destinationFound := findFlightPointByIata(flightLeg.DatedFlight, flightLeg.Leg.OffPointIataCode)
 	if !originFound && !destinationFound {
-		return civil.DateTime{}, civil.DateTime{}, fmt.Errorf("no flight point found")
+		return civil.DateTime{}, civil.DateTime{}, entity.NewError(entity.ErrNotFound, "no flight point found for the leg")
 	}
 
 	var departureDateTime civil.DateTime
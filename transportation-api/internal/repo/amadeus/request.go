@@ -6,11 +6,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"kompass/internal/repo"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
+// tokenExpiryMargin renews access tokens before they expire, so they don't
+// expire on the way to Amadeus.
+const tokenExpiryMargin = time.Minute
+
 func (a *AmadeusWebAPI) requestFlights(ctx context.Context, date civil.Date, flightNumber string) (FlightStatusResponse, error) {
 	urlFormat := "%s/v2/schedule/flights?carrierCode=%s&flightNumber=%s&scheduledDepartureDate=%s"
 	scheduleUrl := fmt.Sprintf(urlFormat, a.baseURL, flightNumber[:2], strings.TrimSpace(flightNumber[2:]), date.String())
@@ -20,21 +26,25 @@ func (a *AmadeusWebAPI) requestFlights(ctx context.Context, date civil.Date, fli
 		return FlightStatusResponse{}, fmt.Errorf("create http request: %w", err)
 	}
 
-	accessToken, err := a.requestToken(ctx)
+	accessToken, err := a.accessToken(ctx)
 	if err != nil {
 		return FlightStatusResponse{}, fmt.Errorf("get access token: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+accessToken.AccessToken)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
 
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return FlightStatusResponse{}, fmt.Errorf("do http request: %w", err)
-	} else if res.StatusCode != 200 {
-		return FlightStatusResponse{}, fmt.Errorf("http status code %d: %w", res.StatusCode, err)
+		return FlightStatusResponse{}, fmt.Errorf("do http request: %w", repo.RequestError(err))
 	}
 
 	defer res.Body.Close()
+	if res.StatusCode == http.StatusUnauthorized {
+		a.invalidateToken(accessToken)
+	}
+	if res.StatusCode != 200 {
+		return FlightStatusResponse{}, repo.StatusError(res.StatusCode)
+	}
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return FlightStatusResponse{}, fmt.Errorf("read response body: %w", err)
@@ -48,6 +58,36 @@ func (a *AmadeusWebAPI) requestFlights(ctx context.Context, date civil.Date, fli
 	return flightStatusResponse, nil
 }
 
+// accessToken returns the shared access token, requesting a new one if it
+// is about to expire. Concurrent requests wait for the same renewal.
+func (a *AmadeusWebAPI) accessToken(ctx context.Context) (string, error) {
+	a.tokenMu.Lock()
+	defer a.tokenMu.Unlock()
+
+	if a.token != "" && time.Now().Before(a.tokenExpiry) {
+		return a.token, nil
+	}
+
+	response, err := a.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	a.token = response.AccessToken
+	a.tokenExpiry = time.Now().Add(time.Duration(response.Expiry)*time.Second - tokenExpiryMargin)
+	return a.token, nil
+}
+
+// invalidateToken drops a token which Amadeus rejected, unless it was
+// renewed in the meantime.
+func (a *AmadeusWebAPI) invalidateToken(token string) {
+	a.tokenMu.Lock()
+	defer a.tokenMu.Unlock()
+
+	if a.token == token {
+		a.token = ""
+	}
+}
+
 func (a *AmadeusWebAPI) requestToken(ctx context.Context) (AccessTokenResponse, error) {
 	endpoint := fmt.Sprintf("%s/v1/security/oauth2/token", a.baseURL)
 
@@ -65,12 +105,13 @@ func (a *AmadeusWebAPI) requestToken(ctx context.Context) (AccessTokenResponse,
 
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return AccessTokenResponse{}, fmt.Errorf("do http request: %w", err)
-	} else if res.StatusCode != 200 {
-		return AccessTokenResponse{}, fmt.Errorf("http status code %d: %w", res.StatusCode, err)
+		return AccessTokenResponse{}, fmt.Errorf("do http request: %w", repo.RequestError(err))
 	}
 
 	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		return AccessTokenResponse{}, repo.StatusError(res.StatusCode)
+	}
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return AccessTokenResponse{}, fmt.Errorf("read response body: %w", err)
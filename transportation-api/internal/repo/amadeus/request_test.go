@@ -0,0 +1,64 @@
+package amadeus
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenIsShared(t *testing.T) {
+	var tokenRequests atomic.Int32
+	var expiresIn atomic.Int32
+	expiresIn.Store(1799)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/security/oauth2/token":
+			n := tokenRequests.Add(1)
+			_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":%d}`, n, expiresIn.Load())
+		case "/v2/schedule/flights":
+			if r.Header.Get("Authorization") == "Bearer token-1" && expiresIn.Load() == 0 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	a := New(config.WebApi{AmadeusBaseURL: server.URL}, nil)
+	date := civil.Date{Year: 2026, Month: 1, Day: 30}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := a.requestFlights(context.Background(), date, "EK412")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), tokenRequests.Load())
+
+	// rejected tokens are renewed
+	expiresIn.Store(0)
+	_, err := a.requestFlights(context.Background(), date, "EK412")
+	require.Error(t, err)
+	_, err = a.requestFlights(context.Background(), date, "EK412")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), tokenRequests.Load())
+
+	// tokens about to expire are renewed
+	_, err = a.requestFlights(context.Background(), date, "EK412")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), tokenRequests.Load())
+}
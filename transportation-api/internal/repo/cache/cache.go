@@ -0,0 +1,220 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/pkg/logger"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// fetchTimeout bounds fetches shared by coalesced requests, which outlive
+// the request that started them.
+const fetchTimeout = time.Minute
+
+// defaultTTLs are the times each method's results are considered fresh.
+// Geocoding and routing hardly change, while flight and train status is
+// realtime data.
+var defaultTTLs = map[string]time.Duration{
+	"RetrieveFlightLeg":      2 * time.Minute,
+	"LookupTrainStation":     7 * 24 * time.Hour,
+	"RetrieveJourney":        2 * time.Minute,
+	"RetrievePolylines":      24 * time.Hour,
+	"RetrieveTrip":           2 * time.Minute,
+	"LookupLocation":         7 * 24 * time.Hour,
+	"AutocompleteLocation":   24 * time.Hour,
+	"ReverseGeocode":         7 * 24 * time.Hour,
+	"ReverseGeocodeLocality": 7 * 24 * time.Hour,
+	"LookupDirections":       24 * time.Hour,
+	"LookupMatrix":           24 * time.Hour,
+	"LookupIsochrones":       7 * 24 * time.Hour,
+	"SearchPois":             24 * time.Hour,
+}
+
+// Store keeps serialised entries until their TTL expired.
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Cache coalesces concurrent identical requests and caches their results.
+// Entries are kept for the stale TTL after they expired, to be served if the
+// upstream is unavailable, times out or rate limits.
+type Cache struct {
+	store    Store
+	ttls     map[string]time.Duration
+	staleTTL time.Duration
+	group    singleflight.Group
+	log      logger.Interface
+}
+
+type entry struct {
+	FreshUntil time.Time       `json:"freshUntil"`
+	Value      json.RawMessage `json:"value"`
+}
+
+func New(config config.Cache, log logger.Interface) (*Cache, error) {
+	var store Store
+	switch config.Backend {
+	case "memory":
+		store = NewLRU(config.Size)
+	case "redis":
+		redis, err := NewRedis(config.RedisURL)
+		if err != nil {
+			return nil, fmt.Errorf("new redis: %w", err)
+		}
+		store = redis
+	case "none":
+		store = noop{}
+	default:
+		return nil, fmt.Errorf("unknown cache backend %s", config.Backend)
+	}
+
+	return NewWithStore(store, config.TTLs, config.StaleTTL, log), nil
+}
+
+// NewWithStore overrides the default TTLs with the given ones.
+func NewWithStore(store Store, ttls map[string]time.Duration, staleTTL time.Duration, log logger.Interface) *Cache {
+	c := &Cache{
+		store:    store,
+		ttls:     map[string]time.Duration{},
+		staleTTL: staleTTL,
+		log:      log,
+	}
+	for method, ttl := range defaultTTLs {
+		c.ttls[method] = ttl
+	}
+	for method, ttl := range ttls {
+		c.ttls[method] = ttl
+	}
+	return c
+}
+
+// get returns the cached result of the method for the given arguments, or
+// fetches it. Each caller decodes its own copy, as results are shared by
+// coalesced requests, which are fetched with the context's values but not its
+// cancellation. Failing stores are logged and bypassed.
+func get[T any](ctx context.Context, c *Cache, namespace string, method string, args any, fetch func(ctx context.Context) (T, error)) (T, error) {
+	var value T
+
+	key, err := cacheKey(namespace, method, args)
+	if err != nil {
+		return value, err
+	}
+
+	cached, found := c.lookup(ctx, key)
+	if found && time.Now().Before(cached.FreshUntil) {
+		var fresh T
+		if json.Unmarshal(cached.Value, &fresh) == nil {
+			return fresh, nil
+		}
+	}
+
+	// the fetch is shared by all callers, so it must not fail because the
+	// first one went away
+	results := c.group.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		defer cancel()
+
+		fetched, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		marshalled, err := json.Marshal(fetched)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", method, err)
+		}
+		c.save(fetchCtx, key, method, marshalled)
+		return marshalled, nil
+	})
+
+	var result singleflight.Result
+	select {
+	case <-ctx.Done():
+		return value, fmt.Errorf("wait for %s: %w", method, ctx.Err())
+	case result = <-results:
+	}
+
+	err = result.Err
+	if err == nil {
+		if err := json.Unmarshal(result.Val.([]byte), &value); err != nil {
+			return value, fmt.Errorf("unmarshal %s: %w", method, err)
+		}
+		return value, nil
+	}
+
+	var stale T
+	if found && isUpstreamFailure(err) && json.Unmarshal(cached.Value, &stale) == nil {
+		c.log.Warn("cache - serving stale %s %s: %v", namespace, method, err)
+		return stale, nil
+	}
+	return stale, err
+}
+
+// isUpstreamFailure reports whether the upstream failed to answer, as opposed
+// to answering that the request can't be served.
+func isUpstreamFailure(err error) bool {
+	return errors.Is(err, entity.ErrUpstreamUnavailable) ||
+		errors.Is(err, entity.ErrUpstreamTimeout) ||
+		errors.Is(err, entity.ErrRateLimited)
+}
+
+func (c *Cache) lookup(ctx context.Context, key string) (entry, bool) {
+	data, found, err := c.store.Get(ctx, key)
+	if err != nil {
+		c.log.Warn("cache - get %s: %v", key, err)
+		return entry{}, false
+	}
+	if !found {
+		return entry{}, false
+	}
+
+	var cached entry
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return entry{}, false
+	}
+	return cached, true
+}
+
+func (c *Cache) save(ctx context.Context, key string, method string, value json.RawMessage) {
+	ttl := c.ttls[method]
+	if ttl <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(entry{FreshUntil: time.Now().Add(ttl), Value: value})
+	if err != nil {
+		c.log.Warn("cache - marshal %s: %v", key, err)
+		return
+	}
+
+	if err := c.store.Set(ctx, key, data, ttl+c.staleTTL); err != nil {
+		c.log.Warn("cache - set %s: %v", key, err)
+	}
+}
+
+func cacheKey(namespace string, method string, args any) (string, error) {
+	marshalled, err := json.Marshal(args)
+	if err != nil {
+		return "", fmt.Errorf("marshal cache key: %w", err)
+	}
+	hash := sha256.Sum256(marshalled)
+	return fmt.Sprintf("kompass:%s:%s:%s", namespace, method, hex.EncodeToString(hash[:])), nil
+}
+
+type noop struct{}
+
+func (noop) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
+func (noop) Set(context.Context, string, []byte, time.Duration) error {
+	return nil
+}
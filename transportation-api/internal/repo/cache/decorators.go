@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"context"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb/geojson"
+)
+
+type FlightInformationWebAPI struct {
+	api   repo.FlightInformationWebAPI
+	cache *Cache
+}
+
+func NewFlightInformationWebAPI(api repo.FlightInformationWebAPI, cache *Cache) *FlightInformationWebAPI {
+	return &FlightInformationWebAPI{api: api, cache: cache}
+}
+
+func (a *FlightInformationWebAPI) RetrieveFlightLeg(ctx context.Context, date civil.Date, flightNumber string, origin *string) (entity.FlightLeg, error) {
+	return get(ctx, a.cache, "flights", "RetrieveFlightLeg", []any{date, flightNumber, origin}, func(ctx context.Context) (entity.FlightLeg, error) {
+		return a.api.RetrieveFlightLeg(ctx, date, flightNumber, origin)
+	})
+}
+
+type DbVendoWebAPI struct {
+	api   repo.DbVendoWebAPI
+	cache *Cache
+}
+
+func NewDbVendoWebAPI(api repo.DbVendoWebAPI, cache *Cache) *DbVendoWebAPI {
+	return &DbVendoWebAPI{api: api, cache: cache}
+}
+
+type polylines struct {
+	Legs      []entity.TrainPolyline `json:"legs"`
+	Transfers []entity.TrainPolyline `json:"transfers"`
+}
+
+// trip keeps the polyline, which TrainTrip omits in its JSON.
+type trip struct {
+	Trip     entity.TrainTrip           `json:"trip"`
+	Polyline *geojson.FeatureCollection `json:"polyline"`
+}
+
+func (a *DbVendoWebAPI) LookupTrainStation(ctx context.Context, query string) (entity.TrainStation, error) {
+	return get(ctx, a.cache, "trains", "LookupTrainStation", query, func(ctx context.Context) (entity.TrainStation, error) {
+		return a.api.LookupTrainStation(ctx, query)
+	})
+}
+
+func (a *DbVendoWebAPI) RetrieveJourney(ctx context.Context, journey request.Train) (entity.Train, error) {
+	return get(ctx, a.cache, "trains", "RetrieveJourney", journey, func(ctx context.Context) (entity.Train, error) {
+		return a.api.RetrieveJourney(ctx, journey)
+	})
+}
+
+func (a *DbVendoWebAPI) RetrievePolylines(ctx context.Context, refreshToken string) ([]entity.TrainPolyline, []entity.TrainPolyline, error) {
+	result, err := get(ctx, a.cache, "trains", "RetrievePolylines", refreshToken, func(ctx context.Context) (polylines, error) {
+		legs, transfers, err := a.api.RetrievePolylines(ctx, refreshToken)
+		return polylines{Legs: legs, Transfers: transfers}, err
+	})
+	return result.Legs, result.Transfers, err
+}
+
+func (a *DbVendoWebAPI) RetrieveTrip(ctx context.Context, trainNumber string, date civil.Date) (entity.TrainTrip, error) {
+	result, err := get(ctx, a.cache, "trains", "RetrieveTrip", []any{trainNumber, date}, func(ctx context.Context) (trip, error) {
+		t, err := a.api.RetrieveTrip(ctx, trainNumber, date)
+		return trip{Trip: t, Polyline: t.Polyline}, err
+	})
+	result.Trip.Polyline = result.Polyline
+	return result.Trip, err
+}
+
+type OpenRouteServiceWebAPI struct {
+	api   repo.OpenRouteServiceWebAPI
+	cache *Cache
+}
+
+func NewOpenRouteServiceWebAPI(api repo.OpenRouteServiceWebAPI, cache *Cache) *OpenRouteServiceWebAPI {
+	return &OpenRouteServiceWebAPI{api: api, cache: cache}
+}
+
+func (a *OpenRouteServiceWebAPI) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
+	return get(ctx, a.cache, "ors", "LookupLocation", query, func(ctx context.Context) (entity.GeocodeLocation, error) {
+		return a.api.LookupLocation(ctx, query)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) AutocompleteLocation(ctx context.Context, autocomplete request.Autocomplete) ([]entity.GeocodePlace, error) {
+	return get(ctx, a.cache, "ors", "AutocompleteLocation", autocomplete, func(ctx context.Context) ([]entity.GeocodePlace, error) {
+		return a.api.AutocompleteLocation(ctx, autocomplete)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return get(ctx, a.cache, "ors", "ReverseGeocode", location, func(ctx context.Context) ([]entity.GeocodePlace, error) {
+		return a.api.ReverseGeocode(ctx, location)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) ReverseGeocodeLocality(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return get(ctx, a.cache, "ors", "ReverseGeocodeLocality", location, func(ctx context.Context) ([]entity.GeocodePlace, error) {
+		return a.api.ReverseGeocodeLocality(ctx, location)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error) {
+	return get(ctx, a.cache, "ors", "LookupDirections", directions, func(ctx context.Context) (entity.Directions, error) {
+		return a.api.LookupDirections(ctx, directions)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) SearchPois(ctx context.Context, pois request.Pois) ([]entity.Poi, error) {
+	return get(ctx, a.cache, "ors", "SearchPois", pois, func(ctx context.Context) ([]entity.Poi, error) {
+		return a.api.SearchPois(ctx, pois)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) LookupMatrix(ctx context.Context, matrix request.Matrix) (entity.Matrix, error) {
+	return get(ctx, a.cache, "ors", "LookupMatrix", matrix, func(ctx context.Context) (entity.Matrix, error) {
+		return a.api.LookupMatrix(ctx, matrix)
+	})
+}
+
+func (a *OpenRouteServiceWebAPI) LookupIsochrones(ctx context.Context, isochrones request.Isochrones) (*geojson.FeatureCollection, error) {
+	return get(ctx, a.cache, "ors", "LookupIsochrones", isochrones, func(ctx context.Context) (*geojson.FeatureCollection, error) {
+		return a.api.LookupIsochrones(ctx, isochrones)
+	})
+}
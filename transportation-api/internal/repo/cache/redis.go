@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisDialTimeout = 2 * time.Second
+	redisIOTimeout   = 2 * time.Second
+)
+
+// Redis is a store backed by a Redis server.
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis accepts URLs like redis://[[username]:password@]host:port[/database],
+// or rediss:// for TLS. Timeouts may be overridden by query parameters such
+// as dial_timeout.
+func NewRedis(rawURL string) (*Redis, error) {
+	options, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis url: %w", err)
+	}
+	if options.DialTimeout == 0 {
+		options.DialTimeout = redisDialTimeout
+	}
+	if options.ReadTimeout == 0 {
+		options.ReadTimeout = redisIOTimeout
+	}
+	if options.WriteTimeout == 0 {
+		options.WriteTimeout = redisIOTimeout
+	}
+	return &Redis{client: redis.NewClient(options)}, nil
+}
+
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("redis get: %w", err)
+	}
+	return value, true, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := r.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("redis set: %w", err)
+	}
+	return nil
+}
@@ -1,27 +1,65 @@
 package repo
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"kompass/internal/entity"
+	"net"
 	"net/http"
+	"net/url"
 )
 
+// UserAgent identifies requests to upstream services, as required by the
+// usage policies of e.g. Nominatim.
+const UserAgent = "kompass"
+
 func RequestAndParseJsonBody[V interface{}](ctx context.Context, method string, url string, requestBody io.Reader) (*V, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url, requestBody)
 	if err != nil {
 		return nil, fmt.Errorf("create http request: %w", err)
 	}
 
+	return doRequestAndParseJsonBody[V](req)
+}
+
+// RequestJsonAndParseJsonBody sends the request body as JSON, along with
+// the given headers.
+func RequestJsonAndParseJsonBody[V interface{}](ctx context.Context, method string, url string, requestBody interface{}, header http.Header) (*V, error) {
+	marshalled, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("create http request: %w", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return doRequestAndParseJsonBody[V](req)
+}
+
+func doRequestAndParseJsonBody[V interface{}](req *http.Request) (*V, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", UserAgent)
+	}
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("do http request: %w", err)
-	} else if res.StatusCode != 200 {
-		return nil, fmt.Errorf("http status code %d", res.StatusCode)
+		return nil, fmt.Errorf("do http request: %w", RequestError(err))
 	}
 
 	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		return nil, StatusError(res.StatusCode)
+	}
+
 	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("read response body: %w", err)
@@ -34,3 +72,35 @@ func RequestAndParseJsonBody[V interface{}](ctx context.Context, method string,
 
 	return &unmarshalled, nil
 }
+
+// RequestError classifies errors of requests which didn't get a response.
+// The request URL is dropped, as it may contain credentials.
+func RequestError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = fmt.Errorf("%s request: %w", urlErr.Op, urlErr.Err)
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return entity.WrapError(entity.ErrUpstreamTimeout, "upstream service timed out", err)
+	}
+	return entity.WrapError(entity.ErrUpstreamUnavailable, "upstream service unavailable", err)
+}
+
+// StatusError classifies unexpected status codes of upstream responses.
+func StatusError(statusCode int) error {
+	err := fmt.Errorf("http status code %d", statusCode)
+	switch statusCode {
+	case http.StatusNotFound:
+		return entity.WrapError(entity.ErrNotFound, "not found", err)
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return entity.WrapError(entity.ErrInvalidInput, "request rejected by upstream service", err)
+	case http.StatusTooManyRequests:
+		return entity.WrapError(entity.ErrRateLimited, "upstream rate limit exceeded", err)
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return entity.WrapError(entity.ErrUpstreamTimeout, "upstream service timed out", err)
+	default:
+		return entity.WrapError(entity.ErrUpstreamUnavailable, "upstream service unavailable", err)
+	}
+}
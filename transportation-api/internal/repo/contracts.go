@@ -6,6 +6,7 @@ import (
 	"kompass/internal/entity"
 
 	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/geojson"
 )
 
@@ -17,12 +18,70 @@ type (
 	DbVendoWebAPI interface {
 		LookupTrainStation(ctx context.Context, query string) (entity.TrainStation, error)
 		RetrieveJourney(ctx context.Context, journey request.Train) (entity.Train, error)
-		RetrievePolylines(ctx context.Context, refreshToken string) ([]geojson.FeatureCollection, error)
+		// RetrievePolylines returns a polyline for each leg of the journey, and
+		// those of the transfers which have one.
+		RetrievePolylines(ctx context.Context, refreshToken string) ([]entity.TrainPolyline, []entity.TrainPolyline, error)
+		RetrieveTrip(ctx context.Context, trainNumber string, date civil.Date) (entity.TrainTrip, error)
 	}
 
-	OpenRouteServiceWebAPI interface {
+	GtfsFeeds interface {
+		LookupStops(ctx context.Context, query string) ([]entity.TransitStop, error)
+		RetrieveTrips(ctx context.Context, request request.TransitTrips) ([]entity.TransitLeg, error)
+		RetrieveLeg(ctx context.Context, request request.TransitLeg) (entity.TransitLeg, error)
+		RetrieveShape(ctx context.Context, feed string, tripID string) (orb.LineString, error)
+	}
+
+	GtfsRealtime interface {
+		RetrieveTripUpdate(feed *string, tripID string, date civil.Date, overnight bool) (entity.TripUpdate, bool)
+		RetrieveVehiclePosition(feed *string, tripID string) (entity.VehiclePosition, bool)
+		RetrieveAlerts(feed *string, routeID *string, stopID *string) []entity.ServiceAlert
+	}
+
+	Geocoder interface {
 		LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error)
-		LookupDirections(ctx context.Context, start entity.Location, end entity.Location, transportationType entity.TransportationType) (*geojson.FeatureCollection, error)
+		AutocompleteLocation(ctx context.Context, request request.Autocomplete) ([]entity.GeocodePlace, error)
+		ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error)
+		// ReverseGeocodeLocality only returns cities, towns and villages.
+		ReverseGeocodeLocality(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error)
+	}
+
+	Router interface {
+		LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error)
+	}
+
+	PoiSearch interface {
+		SearchPois(ctx context.Context, pois request.Pois) ([]entity.Poi, error)
+	}
+
+	OpenRouteServiceWebAPI interface {
+		Geocoder
+		Router
+		PoiSearch
+		LookupMatrix(ctx context.Context, matrix request.Matrix) (entity.Matrix, error)
+		LookupIsochrones(ctx context.Context, isochrones request.Isochrones) (*geojson.FeatureCollection, error)
+	}
+
+	WatchStore interface {
+		CreateWatch(ctx context.Context, watch entity.Watch) error
+		// UpdateWatch fails with entity.ErrNotFound for deleted watches.
+		UpdateWatch(ctx context.Context, watch entity.Watch) error
+		RetrieveWatch(ctx context.Context, id string) (entity.Watch, error)
+		ListWatches(ctx context.Context) ([]entity.Watch, error)
+		DeleteWatch(ctx context.Context, id string) error
+	}
+
+	WebhookSender interface {
+		// CheckURL fails with entity.ErrInvalidInput for URLs which events
+		// must not be sent to.
+		CheckURL(ctx context.Context, url string) error
+		SendWebhook(ctx context.Context, url string, event entity.WatchEvent) error
+	}
+
+	PushSender interface {
+		VapidKey() (entity.VapidKey, error)
+		// SendPush fails with entity.ErrSubscriptionExpired for subscriptions
+		// which should be removed.
+		SendPush(ctx context.Context, subscription entity.PushSubscription, message entity.PushMessage) error
 	}
 
 	IataLookup interface {
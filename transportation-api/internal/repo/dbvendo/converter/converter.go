@@ -10,18 +10,43 @@ import (
 
 // goverter:converter
 // goverter:extend ParseTimestamp
+// goverter:extend ParseOptionalTimestamp
 type TrainConverter interface {
-	// goverter:ignore GeoJson
+	// goverter:ignore GeoJson Transfers
 	ConvertJourney(source response.Journey) (entity.Train, error)
 	// goverter:map PlannedDeparture DepartureDateTime
 	// goverter:map PlannedArrival ArrivalDateTime
+	// goverter:map Departure RealtimeDepartureDateTime
+	// goverter:map Arrival RealtimeArrivalDateTime
 	// goverter:map Line.Name LineName
 	// goverter:map Line.Operator.Name OperatorName
+	// goverter:map . DeparturePlatform | LegDeparturePlatform
+	// goverter:map . ArrivalPlatform | LegArrivalPlatform
 	// goverter:useZeroValueOnPointerInconsistency
 	// TODO!
 	// goverter:ignore DurationInMinutes
 	ConvertLeg(source response.Leg) (entity.TrainLeg, error)
 
+	// goverter:map . Type | TransferType
+	// goverter:map PlannedDeparture DepartureDateTime
+	// goverter:map PlannedArrival ArrivalDateTime
+	// goverter:map Distance DistanceInMeters
+	// goverter:ignore DurationInMinutes Geometry
+	ConvertTransfer(source response.Leg) (entity.TrainTransfer, error)
+
+	// goverter:map Line.Name LineName
+	// goverter:map Line.Operator.Name OperatorName
+	// goverter:useZeroValueOnPointerInconsistency
+	ConvertTrip(source response.Trip) (entity.TrainTrip, error)
+
+	// goverter:map Stop Station
+	// goverter:map PlannedArrival ArrivalDateTime
+	// goverter:map PlannedDeparture DepartureDateTime
+	// goverter:map Arrival RealtimeArrivalDateTime
+	// goverter:map Departure RealtimeDepartureDateTime
+	// goverter:map . Platform | StopoverPlatform
+	ConvertStopover(source response.Stopover) (entity.TrainStopover, error)
+
 	ConvertStation(source response.StationOrStop) entity.TrainStation
 
 	ConvertLocation(source response.Location) entity.Location
@@ -31,3 +56,44 @@ func ParseTimestamp(timestamp string) (civil.DateTime, error) {
 	parts := strings.Split(timestamp, "+")
 	return civil.ParseDateTime(parts[0])
 }
+
+func ParseOptionalTimestamp(timestamp *string) (*civil.DateTime, error) {
+	if timestamp == nil {
+		return nil, nil
+	}
+
+	parsed, err := ParseTimestamp(*timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func TransferType(source response.Leg) entity.TrainTransferType {
+	if source.Walking {
+		return entity.WALKING
+	}
+	return entity.TRANSFER
+}
+
+func LegDeparturePlatform(source response.Leg) *string {
+	return platformOrPlanned(source.DeparturePlatform, source.PlannedDeparturePlatform)
+}
+
+func LegArrivalPlatform(source response.Leg) *string {
+	return platformOrPlanned(source.ArrivalPlatform, source.PlannedArrivalPlatform)
+}
+
+func StopoverPlatform(source response.Stopover) *string {
+	if platform := platformOrPlanned(source.DeparturePlatform, source.PlannedDeparturePlatform); platform != nil {
+		return platform
+	}
+	return platformOrPlanned(source.ArrivalPlatform, source.PlannedArrivalPlatform)
+}
+
+func platformOrPlanned(platform *string, plannedPlatform *string) *string {
+	if platform != nil {
+		return platform
+	}
+	return plannedPlatform
+}
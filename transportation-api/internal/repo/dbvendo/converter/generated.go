@@ -27,6 +27,7 @@ func (c *TrainConverterImpl) ConvertJourney(source response.Journey) (entity.Tra
 }
 func (c *TrainConverterImpl) ConvertLeg(source response.Leg) (entity.TrainLeg, error) {
 	var entityTrainLeg entity.TrainLeg
+	entityTrainLeg.TripID = source.TripID
 	entityTrainLeg.Origin = c.ConvertStation(source.Origin)
 	entityTrainLeg.Destination = c.ConvertStation(source.Destination)
 	civilDateTime, err := ParseTimestamp(source.PlannedDeparture)
@@ -34,11 +35,22 @@ func (c *TrainConverterImpl) ConvertLeg(source response.Leg) (entity.TrainLeg, e
 		return entityTrainLeg, err
 	}
 	entityTrainLeg.DepartureDateTime = civilDateTime
+	pCivilDateTime, err := ParseOptionalTimestamp(source.Departure)
+	if err != nil {
+		return entityTrainLeg, err
+	}
+	entityTrainLeg.RealtimeDepartureDateTime = pCivilDateTime
 	civilDateTime2, err := ParseTimestamp(source.PlannedArrival)
 	if err != nil {
 		return entityTrainLeg, err
 	}
 	entityTrainLeg.ArrivalDateTime = civilDateTime2
+	pCivilDateTime2, err := ParseOptionalTimestamp(source.Arrival)
+	if err != nil {
+		return entityTrainLeg, err
+	}
+	entityTrainLeg.RealtimeArrivalDateTime = pCivilDateTime2
+	entityTrainLeg.Cancelled = source.Cancelled
 	var pString *string
 	if source.Line != nil {
 		pString = &source.Line.Name
@@ -53,6 +65,18 @@ func (c *TrainConverterImpl) ConvertLeg(source response.Leg) (entity.TrainLeg, e
 	if pString2 != nil {
 		entityTrainLeg.OperatorName = *pString2
 	}
+	entityTrainLeg.DeparturePlatform = LegDeparturePlatform(source)
+	entityTrainLeg.ArrivalPlatform = LegArrivalPlatform(source)
+	if source.Stopovers != nil {
+		entityTrainLeg.Stopovers = make([]entity.TrainStopover, len(source.Stopovers))
+		for i := 0; i < len(source.Stopovers); i++ {
+			entityTrainStopover, err := c.ConvertStopover(source.Stopovers[i])
+			if err != nil {
+				return entityTrainLeg, err
+			}
+			entityTrainLeg.Stopovers[i] = entityTrainStopover
+		}
+	}
 	return entityTrainLeg, nil
 }
 func (c *TrainConverterImpl) ConvertLocation(source response.Location) entity.Location {
@@ -68,3 +92,78 @@ func (c *TrainConverterImpl) ConvertStation(source response.StationOrStop) entit
 	entityTrainStation.Location = c.ConvertLocation(source.Location)
 	return entityTrainStation
 }
+func (c *TrainConverterImpl) ConvertStopover(source response.Stopover) (entity.TrainStopover, error) {
+	var entityTrainStopover entity.TrainStopover
+	entityTrainStopover.Station = c.ConvertStation(source.Stop)
+	pCivilDateTime, err := ParseOptionalTimestamp(source.PlannedArrival)
+	if err != nil {
+		return entityTrainStopover, err
+	}
+	entityTrainStopover.ArrivalDateTime = pCivilDateTime
+	pCivilDateTime2, err := ParseOptionalTimestamp(source.Arrival)
+	if err != nil {
+		return entityTrainStopover, err
+	}
+	entityTrainStopover.RealtimeArrivalDateTime = pCivilDateTime2
+	pCivilDateTime3, err := ParseOptionalTimestamp(source.PlannedDeparture)
+	if err != nil {
+		return entityTrainStopover, err
+	}
+	entityTrainStopover.DepartureDateTime = pCivilDateTime3
+	pCivilDateTime4, err := ParseOptionalTimestamp(source.Departure)
+	if err != nil {
+		return entityTrainStopover, err
+	}
+	entityTrainStopover.RealtimeDepartureDateTime = pCivilDateTime4
+	entityTrainStopover.Platform = StopoverPlatform(source)
+	entityTrainStopover.Cancelled = source.Cancelled
+	return entityTrainStopover, nil
+}
+func (c *TrainConverterImpl) ConvertTransfer(source response.Leg) (entity.TrainTransfer, error) {
+	var entityTrainTransfer entity.TrainTransfer
+	entityTrainTransfer.Type = TransferType(source)
+	entityTrainTransfer.Origin = c.ConvertStation(source.Origin)
+	entityTrainTransfer.Destination = c.ConvertStation(source.Destination)
+	civilDateTime, err := ParseTimestamp(source.PlannedDeparture)
+	if err != nil {
+		return entityTrainTransfer, err
+	}
+	entityTrainTransfer.DepartureDateTime = civilDateTime
+	civilDateTime2, err := ParseTimestamp(source.PlannedArrival)
+	if err != nil {
+		return entityTrainTransfer, err
+	}
+	entityTrainTransfer.ArrivalDateTime = civilDateTime2
+	entityTrainTransfer.DistanceInMeters = source.Distance
+	return entityTrainTransfer, nil
+}
+func (c *TrainConverterImpl) ConvertTrip(source response.Trip) (entity.TrainTrip, error) {
+	var entityTrainTrip entity.TrainTrip
+	entityTrainTrip.ID = source.ID
+	var pString *string
+	if source.Line != nil {
+		pString = &source.Line.Name
+	}
+	if pString != nil {
+		entityTrainTrip.LineName = *pString
+	}
+	var pString2 *string
+	if source.Line != nil {
+		pString2 = &source.Line.Operator.Name
+	}
+	if pString2 != nil {
+		entityTrainTrip.OperatorName = *pString2
+	}
+	if source.Stopovers != nil {
+		entityTrainTrip.Stopovers = make([]entity.TrainStopover, len(source.Stopovers))
+		for i := 0; i < len(source.Stopovers); i++ {
+			entityTrainStopover, err := c.ConvertStopover(source.Stopovers[i])
+			if err != nil {
+				return entityTrainTrip, err
+			}
+			entityTrainTrip.Stopovers[i] = entityTrainStopover
+		}
+	}
+	entityTrainTrip.Polyline = source.Polyline
+	return entityTrainTrip, nil
+}
@@ -14,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/geojson"
 )
 
@@ -23,8 +25,13 @@ type DbVendoWebAPI struct {
 }
 
 func New(config config.WebApi) *DbVendoWebAPI {
+	return NewWithBaseURL(config.DbVendoBaseURL)
+}
+
+// NewWithBaseURL creates a client for any FPTF-compatible hafas-rest-api backend.
+func NewWithBaseURL(baseURL string) *DbVendoWebAPI {
 	return &DbVendoWebAPI{
-		baseURL: config.DbVendoBaseURL,
+		baseURL: baseURL,
 		c:       &converter.TrainConverterImpl{},
 	}
 }
@@ -39,30 +46,90 @@ func (a *DbVendoWebAPI) LookupTrainStation(ctx context.Context, query string) (e
 	}
 
 	if len(*results) == 0 {
-		return entity.TrainStation{}, fmt.Errorf("no train stations found")
+		return entity.TrainStation{}, entity.NewError(entity.ErrNotFound, "no train stations found")
 	}
 
 	return a.c.ConvertStation((*results)[0]), nil
 }
 
-func (a *DbVendoWebAPI) RetrievePolylines(ctx context.Context, refreshToken string) ([]geojson.FeatureCollection, error) {
+func (a *DbVendoWebAPI) RetrievePolylines(ctx context.Context, refreshToken string) ([]entity.TrainPolyline, []entity.TrainPolyline, error) {
 	urlFormat := "%s/journeys/%s?polylines=true"
 	url := fmt.Sprintf(urlFormat, a.baseURL, refreshToken)
 
 	rsp, err := repo.RequestAndParseJsonBody[response.JourneyResponse](ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+		return nil, nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
 	}
 
-	featureCollections := []geojson.FeatureCollection{}
+	legPolylines := []entity.TrainPolyline{}
+	transferPolylines := []entity.TrainPolyline{}
 
 	for _, leg := range rsp.Journey.Legs {
+		polyline := entity.TrainPolyline{
+			OriginID:      leg.Origin.ID,
+			DestinationID: leg.Destination.ID,
+			Polyline:      *geojson.NewFeatureCollection(),
+		}
 		if leg.Polyline != nil {
-			featureCollections = append(featureCollections, *leg.Polyline)
+			polyline.Polyline = *leg.Polyline
+		}
+
+		// legs are kept without polyline, so they match the legs of the journey
+		if leg.Line != nil {
+			legPolylines = append(legPolylines, polyline)
+		} else if isTransfer(leg) && leg.Polyline != nil {
+			transferPolylines = append(transferPolylines, polyline)
+		}
+	}
+
+	return legPolylines, transferPolylines, nil
+}
+
+func (a *DbVendoWebAPI) RetrieveTrip(ctx context.Context, trainNumber string, date civil.Date) (entity.TrainTrip, error) {
+	params := url.Values{
+		"query":                {trainNumber},
+		"fromWhen":             {civil.DateTime{Date: date}.String()},
+		"untilWhen":            {civil.DateTime{Date: date.AddDays(1)}.String()},
+		"onlyCurrentlyRunning": {"false"},
+	}
+	tripsUrl := a.baseURL + "/trips?" + params.Encode()
+
+	trips, err := repo.RequestAndParseJsonBody[response.TripsResponse](ctx, "GET", tripsUrl, nil)
+	if err != nil {
+		return entity.TrainTrip{}, fmt.Errorf("retrieveTrips: %w", err)
+	}
+
+	tripID, ok := findTrip(trips.Trips, trainNumber, date)
+	if !ok {
+		return entity.TrainTrip{}, entity.NewError(entity.ErrNotFound, "no matching trip found")
+	}
+
+	urlFormat := "%s/trips/%s?stopovers=true&polyline=true"
+	tripUrl := fmt.Sprintf(urlFormat, a.baseURL, url.PathEscape(tripID))
+
+	rsp, err := repo.RequestAndParseJsonBody[response.TripResponse](ctx, "GET", tripUrl, nil)
+	if err != nil {
+		return entity.TrainTrip{}, fmt.Errorf("retrieveTrip: %w", err)
+	}
+
+	return a.c.ConvertTrip(rsp.Trip)
+}
+
+func findTrip(trips []response.Trip, trainNumber string, date civil.Date) (string, bool) {
+	for _, trip := range trips {
+		if trip.Line == nil || !equalIgnoringWhitespaceAndCase(trip.Line.Name, trainNumber) {
+			continue
+		}
+
+		departure, err := converter.ParseTimestamp(trip.PlannedDeparture)
+		if err == nil && departure.Date != date {
+			continue
 		}
+
+		return trip.ID, true
 	}
 
-	return featureCollections, nil
+	return "", false
 }
 
 const MaxRetries = 10
@@ -90,7 +157,7 @@ func (a *DbVendoWebAPI) RetrieveJourney(ctx context.Context, request request.Tra
 		}
 	}
 
-	return entity.Train{}, fmt.Errorf("no journeys found after %d tries", MaxRetries)
+	return entity.Train{}, entity.NewError(entity.ErrNotFound, fmt.Sprintf("no matching journey found after %d tries", MaxRetries))
 }
 
 func (a *DbVendoWebAPI) journeyUrl(journey request.Train, laterThan *string) string {
@@ -99,6 +166,7 @@ func (a *DbVendoWebAPI) journeyUrl(journey request.Train, laterThan *string) str
 		"to":        {journey.ToStationID},
 		"transfers": {strconv.Itoa(len(journey.TrainNumbers) - 1)},
 		"results":   {"10"},
+		"stopovers": {"true"},
 	}
 	if laterThan != nil {
 		params.Add("laterThan", *laterThan)
@@ -113,9 +181,19 @@ func (a *DbVendoWebAPI) journeyUrl(journey request.Train, laterThan *string) str
 
 func (a *DbVendoWebAPI) convertJourney(source response.Journey) (entity.Train, error) {
 	legs := []entity.TrainLeg{}
+	transfers := []entity.TrainTransfer{}
 
 	for _, leg := range source.Legs {
 		if leg.Line == nil {
+			if !isTransfer(leg) {
+				continue
+			}
+
+			transfer, err := a.convertTransfer(leg)
+			if err != nil {
+				return entity.Train{}, err
+			}
+			transfers = append(transfers, transfer)
 			continue
 		}
 
@@ -132,9 +210,38 @@ func (a *DbVendoWebAPI) convertJourney(source response.Journey) (entity.Train, e
 	return entity.Train{
 		RefreshToken: source.RefreshToken,
 		Legs:         legs,
+		Transfers:    transfers,
 	}, nil
 }
 
+func (a *DbVendoWebAPI) convertTransfer(leg response.Leg) (entity.TrainTransfer, error) {
+	transfer, err := a.c.ConvertTransfer(leg)
+	if err != nil {
+		return entity.TrainTransfer{}, err
+	}
+
+	from := transfer.DepartureDateTime.In(time.UTC)
+	to := transfer.ArrivalDateTime.In(time.UTC)
+	transfer.DurationInMinutes = int32(to.Sub(from).Minutes())
+	transfer.Geometry = geojson.NewGeometry(orb.LineString{
+		locationToPoint(transfer.Origin.Location),
+		locationToPoint(transfer.Destination.Location),
+	})
+
+	return transfer, nil
+}
+
+func isTransfer(leg response.Leg) bool {
+	return leg.Walking || leg.Transfer
+}
+
+func locationToPoint(location entity.Location) orb.Point {
+	return orb.Point{
+		float64(location.Longitude),
+		float64(location.Latitude),
+	}
+}
+
 func checkJourneys(journeys []response.Journey, request request.Train) (response.Journey, bool) {
 journeyLoop:
 	for _, journey := range journeys {
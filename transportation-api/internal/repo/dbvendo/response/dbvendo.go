@@ -29,13 +29,56 @@ type Operator struct {
 }
 
 type Leg struct {
-	TripID           string                     `json:"tripId"`
+	TripID                   string                     `json:"tripId"`
+	Origin                   StationOrStop              `json:"origin"`
+	Destination              StationOrStop              `json:"destination"`
+	PlannedDeparture         string                     `json:"plannedDeparture"`
+	Departure                *string                    `json:"departure"`
+	PlannedArrival           string                     `json:"plannedArrival"`
+	Arrival                  *string                    `json:"arrival"`
+	Cancelled                bool                       `json:"cancelled"`
+	DeparturePlatform        *string                    `json:"departurePlatform"`
+	PlannedDeparturePlatform *string                    `json:"plannedDeparturePlatform"`
+	ArrivalPlatform          *string                    `json:"arrivalPlatform"`
+	PlannedArrivalPlatform   *string                    `json:"plannedArrivalPlatform"`
+	Line                     *Line                      `json:"line" validate:"optional" extensions:"nullable"`
+	Walking                  bool                       `json:"walking"`
+	Transfer                 bool                       `json:"transfer"`
+	Distance                 *int32                     `json:"distance"`
+	Stopovers                []Stopover                 `json:"stopovers"`
+	Polyline                 *geojson.FeatureCollection `json:"polyline,omitempty" validate:"optional" extensions:"nullable"`
+}
+
+type Stopover struct {
+	Stop                     StationOrStop `json:"stop"`
+	PlannedArrival           *string       `json:"plannedArrival"`
+	Arrival                  *string       `json:"arrival"`
+	PlannedDeparture         *string       `json:"plannedDeparture"`
+	Departure                *string       `json:"departure"`
+	DeparturePlatform        *string       `json:"departurePlatform"`
+	PlannedDeparturePlatform *string       `json:"plannedDeparturePlatform"`
+	ArrivalPlatform          *string       `json:"arrivalPlatform"`
+	PlannedArrivalPlatform   *string       `json:"plannedArrivalPlatform"`
+	Cancelled                bool          `json:"cancelled"`
+}
+
+type Trip struct {
+	ID               string                     `json:"id"`
 	Origin           StationOrStop              `json:"origin"`
 	Destination      StationOrStop              `json:"destination"`
 	PlannedDeparture string                     `json:"plannedDeparture"`
 	PlannedArrival   string                     `json:"plannedArrival"`
-	Line             *Line                      `json:"line" validate:"optional" extensions:"nullable"`
-	Polyline         *geojson.FeatureCollection `json:"polyline,omitempty" validate:"optional" extensions:"nullable"`
+	Line             *Line                      `json:"line"`
+	Stopovers        []Stopover                 `json:"stopovers"`
+	Polyline         *geojson.FeatureCollection `json:"polyline,omitempty"`
+}
+
+type TripResponse struct {
+	Trip Trip `json:"trip"`
+}
+
+type TripsResponse struct {
+	Trips []Trip `json:"trips"`
 }
 
 type Journey struct {
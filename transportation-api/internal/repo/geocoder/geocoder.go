@@ -0,0 +1,147 @@
+package geocoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+const defaultResultSize = 10
+
+// Fallback queries the configured geocoders in order and returns the first
+// successful, non-empty result.
+type Fallback struct {
+	names     []string
+	geocoders []repo.Geocoder
+}
+
+// New selects the geocoders by name, in the given order.
+func New(names []string, available map[string]repo.Geocoder) (*Fallback, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no geocoder configured")
+	}
+
+	fallback := &Fallback{}
+	for _, name := range names {
+		g, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown geocoder %s", name)
+		}
+		fallback.names = append(fallback.names, name)
+		fallback.geocoders = append(fallback.geocoders, g)
+	}
+	return fallback, nil
+}
+
+func (f *Fallback) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
+	var errs []error
+	for i, g := range f.geocoders {
+		location, err := g.LookupLocation(ctx, query)
+		if err == nil {
+			return location, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", f.names[i], err))
+	}
+	return entity.GeocodeLocation{}, errors.Join(errs...)
+}
+
+func (f *Fallback) AutocompleteLocation(ctx context.Context, request request.Autocomplete) ([]entity.GeocodePlace, error) {
+	return f.firstNonEmpty(func(g repo.Geocoder) ([]entity.GeocodePlace, error) {
+		return g.AutocompleteLocation(ctx, request)
+	})
+}
+
+func (f *Fallback) ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return f.firstNonEmpty(func(g repo.Geocoder) ([]entity.GeocodePlace, error) {
+		return g.ReverseGeocode(ctx, location)
+	})
+}
+
+func (f *Fallback) ReverseGeocodeLocality(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return f.firstNonEmpty(func(g repo.Geocoder) ([]entity.GeocodePlace, error) {
+		return g.ReverseGeocodeLocality(ctx, location)
+	})
+}
+
+// firstNonEmpty only fails if all geocoders failed. An empty result is
+// returned if at least one geocoder answered without finding anything.
+func (f *Fallback) firstNonEmpty(lookup func(repo.Geocoder) ([]entity.GeocodePlace, error)) ([]entity.GeocodePlace, error) {
+	var errs []error
+	var empty []entity.GeocodePlace
+	for i, g := range f.geocoders {
+		places, err := lookup(g)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.names[i], err))
+			continue
+		}
+		if len(places) > 0 {
+			return places, nil
+		}
+		empty = places
+	}
+
+	if empty != nil {
+		return empty, nil
+	}
+	return nil, errors.Join(errs...)
+}
+
+func ResultSize(size *int) int {
+	if size == nil {
+		return defaultResultSize
+	}
+	return *size
+}
+
+// FilterPlaces applies the country and layer filters of the request for
+// backends which can't filter themselves.
+func FilterPlaces(places []entity.GeocodePlace, request request.Autocomplete) []entity.GeocodePlace {
+	filtered := []entity.GeocodePlace{}
+	for _, place := range places {
+		if len(request.Countries) > 0 && (place.Address.CountryCode == nil ||
+			!slices.ContainsFunc(request.Countries, func(country string) bool {
+				return strings.EqualFold(country, *place.Address.CountryCode)
+			})) {
+			continue
+		}
+		if len(request.Layers) > 0 && !slices.Contains(request.Layers, place.Layer) {
+			continue
+		}
+		filtered = append(filtered, place)
+	}
+	return filtered
+}
+
+// SetDistances sets the distance of each place to the given location, for
+// backends which don't report it.
+func SetDistances(places []entity.GeocodePlace, location entity.Location) {
+	from := orb.Point{float64(location.Longitude), float64(location.Latitude)}
+	for i := range places {
+		to := orb.Point{float64(places[i].Longitude), float64(places[i].Latitude)}
+		distance := geo.Distance(from, to) / 1000
+		places[i].DistanceInKilometers = &distance
+	}
+}
+
+func SortByDistance(places []entity.GeocodePlace) {
+	sort.SliceStable(places, func(i, j int) bool {
+		return distance(places[i]) < distance(places[j])
+	})
+}
+
+func distance(place entity.GeocodePlace) float64 {
+	if place.DistanceInKilometers == nil {
+		return math.Inf(1)
+	}
+	return *place.DistanceInKilometers
+}
@@ -0,0 +1,84 @@
+package geocoder
+
+import (
+	"context"
+	"errors"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGeocoder struct {
+	places []entity.GeocodePlace
+	err    error
+}
+
+func (f fakeGeocoder) LookupLocation(context.Context, string) (entity.GeocodeLocation, error) {
+	if f.err != nil || len(f.places) == 0 {
+		return entity.GeocodeLocation{}, errors.Join(f.err, errors.New("no locations found"))
+	}
+	return entity.GeocodeLocation{Label: f.places[0].Label}, nil
+}
+
+func (f fakeGeocoder) AutocompleteLocation(context.Context, request.Autocomplete) ([]entity.GeocodePlace, error) {
+	return f.places, f.err
+}
+
+func (f fakeGeocoder) ReverseGeocode(context.Context, entity.Location) ([]entity.GeocodePlace, error) {
+	return f.places, f.err
+}
+
+func (f fakeGeocoder) ReverseGeocodeLocality(context.Context, entity.Location) ([]entity.GeocodePlace, error) {
+	return f.places, f.err
+}
+
+func TestFallback(t *testing.T) {
+	available := map[string]repo.Geocoder{
+		"failing": fakeGeocoder{err: errors.New("unavailable")},
+		"empty":   fakeGeocoder{places: []entity.GeocodePlace{}},
+		"working": fakeGeocoder{places: []entity.GeocodePlace{{Label: "Berlin"}}},
+	}
+
+	fallback, err := New([]string{"failing", "empty", "working"}, available)
+	require.NoError(t, err)
+
+	places, err := fallback.AutocompleteLocation(context.Background(), request.Autocomplete{Text: "Berlin"})
+	require.NoError(t, err)
+	assert.Equal(t, "Berlin", places[0].Label)
+
+	location, err := fallback.LookupLocation(context.Background(), "Berlin")
+	require.NoError(t, err)
+	assert.Equal(t, "Berlin", location.Label)
+
+	fallback, err = New([]string{"failing", "empty"}, available)
+	require.NoError(t, err)
+	places, err = fallback.ReverseGeocode(context.Background(), entity.Location{})
+	require.NoError(t, err)
+	assert.Empty(t, places)
+
+	fallback, err = New([]string{"failing"}, available)
+	require.NoError(t, err)
+	_, err = fallback.ReverseGeocode(context.Background(), entity.Location{})
+	assert.ErrorContains(t, err, "failing: unavailable")
+
+	_, err = New([]string{"unknown"}, available)
+	assert.Error(t, err)
+}
+
+func TestFilterPlaces(t *testing.T) {
+	de, at := "DE", "AT"
+	places := []entity.GeocodePlace{
+		{Name: "Berlin", Layer: "locality", Address: entity.GeocodeAddress{CountryCode: &de}},
+		{Name: "Wien", Layer: "locality", Address: entity.GeocodeAddress{CountryCode: &at}},
+		{Name: "Brandenburger Tor", Layer: "venue", Address: entity.GeocodeAddress{CountryCode: &de}},
+	}
+
+	filtered := FilterPlaces(places, request.Autocomplete{Countries: []string{"de"}, Layers: []string{"locality"}})
+
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "Berlin", filtered[0].Name)
+}
@@ -0,0 +1,119 @@
+package graphhopper
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/router"
+	"net/url"
+	"strconv"
+)
+
+type GraphHopperWebAPI struct {
+	baseURL string
+	apiKey  string
+}
+
+func New(config config.WebApi) *GraphHopperWebAPI {
+	return &GraphHopperWebAPI{
+		baseURL: config.GraphHopperBaseURL,
+		apiKey:  config.GraphHopperApiKey,
+	}
+}
+
+// routeResponse is parsed without orb, which drops the elevation of 3D
+// coordinates. Times are given in milliseconds.
+type routeResponse struct {
+	Paths []struct {
+		Distance float64 `json:"distance"`
+		Time     float64 `json:"time"`
+		Ascend   float64 `json:"ascend"`
+		Descend  float64 `json:"descend"`
+		Points   struct {
+			Coordinates [][]float64 `json:"coordinates"`
+		} `json:"points"`
+		Instructions []struct {
+			Text       string  `json:"text"`
+			StreetName string  `json:"street_name"`
+			Distance   float64 `json:"distance"`
+			Time       float64 `json:"time"`
+		} `json:"instructions"`
+	} `json:"paths"`
+}
+
+// LookupDirections ignores the avoid options, as GraphHopper only supports
+// them through custom models, which require the flexible mode.
+func (a *GraphHopperWebAPI) LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error) {
+	params := url.Values{}
+	for _, location := range router.Locations(directions) {
+		params.Add("point", fmt.Sprintf("%f,%f", location.Latitude, location.Longitude))
+	}
+	params.Set("profile", getProfileByTransportationType(directions.TransportationType))
+	params.Set("points_encoded", "false")
+	params.Set("instructions", "true")
+	params.Set("elevation", "true")
+	if directions.Language != nil {
+		params.Set("locale", *directions.Language)
+	}
+	if directions.Alternatives != nil && *directions.Alternatives > 1 {
+		params.Set("algorithm", "alternative_route")
+		params.Set("alternative_route.max_paths", strconv.Itoa(*directions.Alternatives))
+		params.Set("ch.disable", "true")
+	}
+	if a.apiKey != "" {
+		params.Set("key", a.apiKey)
+	}
+	routeUrl := fmt.Sprintf("%s/route?%s", a.baseURL, params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[routeResponse](ctx, "GET", routeUrl, nil)
+	if err != nil {
+		return entity.Directions{}, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+
+	if len(result.Paths) == 0 {
+		return entity.Directions{}, entity.NewError(entity.ErrNotFound, "no route found")
+	}
+
+	routes := []router.Route{}
+	for _, path := range result.Paths {
+		lineString, elevations := router.SplitElevations(path.Points.Coordinates)
+
+		steps := []entity.RouteStep{}
+		for _, instruction := range path.Instructions {
+			steps = append(steps, entity.RouteStep{
+				Instruction:       instruction.Text,
+				Name:              instruction.StreetName,
+				DistanceInMeters:  instruction.Distance,
+				DurationInSeconds: instruction.Time / 1000,
+			})
+		}
+
+		route := router.Route{
+			LineString: lineString,
+			Elevations: elevations,
+			Distance:   path.Distance,
+			Duration:   path.Time / 1000,
+			Steps:      steps,
+		}
+		if elevations != nil {
+			route.Ascent, route.Descent = &path.Ascend, &path.Descend
+		}
+		routes = append(routes, route)
+	}
+
+	return router.NewDirections(routes, directions.Units), nil
+}
+
+func getProfileByTransportationType(transportationType entity.TransportationType) string {
+	switch transportationType {
+	case entity.BIKE:
+		return "bike"
+	case entity.HIKE:
+		return "foot"
+	default:
+		return "car"
+	}
+}
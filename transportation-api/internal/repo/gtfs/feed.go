@@ -0,0 +1,354 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
+)
+
+type agency struct {
+	name     string
+	timezone string
+}
+
+type stop struct {
+	id            string
+	name          string
+	parentStation string
+	location      orb.Point
+}
+
+type route struct {
+	id        string
+	agencyID  string
+	shortName string
+	longName  string
+	routeType int
+}
+
+type trip struct {
+	id        string
+	routeID   string
+	serviceID string
+	shapeID   string
+	headsign  string
+}
+
+type stopTime struct {
+	stopID    string
+	arrival   int32
+	departure int32
+	sequence  int32
+}
+
+type service struct {
+	weekdays  [7]bool
+	startDate civil.Date
+	endDate   civil.Date
+}
+
+// feed is the in-memory index of a single imported GTFS feed.
+type feed struct {
+	name              string
+	agencies          map[string]agency
+	stops             map[string]stop
+	routes            map[string]route
+	trips             map[string]trip
+	tripsByRoute      map[string][]string
+	stopTimes         map[string][]stopTime
+	services          map[string]service
+	serviceExceptions map[string]map[civil.Date]bool
+	shapes            map[string]orb.LineString
+}
+
+func parseFeed(name string, archive *zip.Reader) (*feed, error) {
+	f := &feed{
+		name:              name,
+		agencies:          map[string]agency{},
+		stops:             map[string]stop{},
+		routes:            map[string]route{},
+		trips:             map[string]trip{},
+		tripsByRoute:      map[string][]string{},
+		stopTimes:         map[string][]stopTime{},
+		services:          map[string]service{},
+		serviceExceptions: map[string]map[civil.Date]bool{},
+		shapes:            map[string]orb.LineString{},
+	}
+
+	files := []struct {
+		name     string
+		required bool
+		parse    func(record) error
+	}{
+		{"agency.txt", true, f.parseAgency},
+		{"stops.txt", true, f.parseStop},
+		{"routes.txt", true, f.parseRoute},
+		{"trips.txt", true, f.parseTrip},
+		{"stop_times.txt", true, f.parseStopTime},
+		{"calendar.txt", false, f.parseService},
+		{"calendar_dates.txt", false, f.parseServiceException},
+		{"shapes.txt", false, f.parseShapePoint},
+	}
+
+	for _, file := range files {
+		err := readFile(archive, file.name, file.parse)
+		if errors.Is(err, errFileNotFound) && !file.required {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", file.name, err)
+		}
+	}
+
+	for _, stopTimes := range f.stopTimes {
+		sort.Slice(stopTimes, func(i, j int) bool {
+			return stopTimes[i].sequence < stopTimes[j].sequence
+		})
+		fillMissingTimes(stopTimes)
+	}
+
+	return f, nil
+}
+
+func (f *feed) parseAgency(r record) error {
+	f.agencies[r.get("agency_id")] = agency{
+		name:     r.get("agency_name"),
+		timezone: r.get("agency_timezone"),
+	}
+	return nil
+}
+
+func (f *feed) parseStop(r record) error {
+	latitude, err1 := strconv.ParseFloat(r.get("stop_lat"), 64)
+	longitude, err2 := strconv.ParseFloat(r.get("stop_lon"), 64)
+	if err := errors.Join(err1, err2); err != nil {
+		// generic nodes and boarding areas may come without coordinates
+		return nil
+	}
+
+	f.stops[r.get("stop_id")] = stop{
+		id:            r.get("stop_id"),
+		name:          r.get("stop_name"),
+		parentStation: r.get("parent_station"),
+		location:      orb.Point{longitude, latitude},
+	}
+	return nil
+}
+
+func (f *feed) parseRoute(r record) error {
+	routeType, err := strconv.Atoi(r.get("route_type"))
+	if err != nil {
+		return fmt.Errorf("parse route_type: %w", err)
+	}
+
+	f.routes[r.get("route_id")] = route{
+		id:        r.get("route_id"),
+		agencyID:  r.get("agency_id"),
+		shortName: r.get("route_short_name"),
+		longName:  r.get("route_long_name"),
+		routeType: routeType,
+	}
+	return nil
+}
+
+func (f *feed) parseTrip(r record) error {
+	t := trip{
+		id:        r.get("trip_id"),
+		routeID:   r.get("route_id"),
+		serviceID: r.get("service_id"),
+		shapeID:   r.get("shape_id"),
+		headsign:  r.get("trip_headsign"),
+	}
+	f.trips[t.id] = t
+	f.tripsByRoute[t.routeID] = append(f.tripsByRoute[t.routeID], t.id)
+	return nil
+}
+
+func (f *feed) parseStopTime(r record) error {
+	arrival, err1 := parseOptionalTime(r.get("arrival_time"))
+	departure, err2 := parseOptionalTime(r.get("departure_time"))
+	sequence, err3 := strconv.Atoi(r.get("stop_sequence"))
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return fmt.Errorf("parse stop time: %w", err)
+	}
+
+	tripID := r.get("trip_id")
+	f.stopTimes[tripID] = append(f.stopTimes[tripID], stopTime{
+		stopID:    r.get("stop_id"),
+		arrival:   arrival,
+		departure: departure,
+		sequence:  int32(sequence),
+	})
+	return nil
+}
+
+func (f *feed) parseService(r record) error {
+	startDate, err1 := parseDate(r.get("start_date"))
+	endDate, err2 := parseDate(r.get("end_date"))
+	if err := errors.Join(err1, err2); err != nil {
+		return fmt.Errorf("parse service dates: %w", err)
+	}
+
+	var weekdays [7]bool
+	for i, day := range []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"} {
+		weekdays[i] = r.get(day) == "1"
+	}
+
+	f.services[r.get("service_id")] = service{
+		weekdays:  weekdays,
+		startDate: startDate,
+		endDate:   endDate,
+	}
+	return nil
+}
+
+func (f *feed) parseServiceException(r record) error {
+	date, err := parseDate(r.get("date"))
+	if err != nil {
+		return fmt.Errorf("parse service exception date: %w", err)
+	}
+
+	serviceID := r.get("service_id")
+	if f.serviceExceptions[serviceID] == nil {
+		f.serviceExceptions[serviceID] = map[civil.Date]bool{}
+	}
+	f.serviceExceptions[serviceID][date] = r.get("exception_type") == "1"
+	return nil
+}
+
+// parseShapePoint relies on shapes.txt being ordered by shape_pt_sequence,
+// which holds for all feeds we know of.
+func (f *feed) parseShapePoint(r record) error {
+	latitude, err1 := strconv.ParseFloat(r.get("shape_pt_lat"), 64)
+	longitude, err2 := strconv.ParseFloat(r.get("shape_pt_lon"), 64)
+	if err := errors.Join(err1, err2); err != nil {
+		return fmt.Errorf("parse shape point: %w", err)
+	}
+
+	shapeID := r.get("shape_id")
+	f.shapes[shapeID] = append(f.shapes[shapeID], orb.Point{longitude, latitude})
+	return nil
+}
+
+// fillMissingTimes carries the last known time over to stops which are no
+// timepoints, as GTFS allows to leave their arrival and departure empty.
+func fillMissingTimes(stopTimes []stopTime) {
+	var last int32
+	for i := range stopTimes {
+		if stopTimes[i].arrival == noTime {
+			stopTimes[i].arrival = max(stopTimes[i].departure, last)
+		}
+		if stopTimes[i].departure == noTime {
+			stopTimes[i].departure = stopTimes[i].arrival
+		}
+		last = stopTimes[i].departure
+	}
+}
+
+// isServiceActive applies calendar.txt and the exceptions of calendar_dates.txt.
+func (f *feed) isServiceActive(serviceID string, date civil.Date) bool {
+	if added, ok := f.serviceExceptions[serviceID][date]; ok {
+		return added
+	}
+
+	s, ok := f.services[serviceID]
+	if !ok || date.Before(s.startDate) || date.After(s.endDate) {
+		return false
+	}
+
+	return s.weekdays[date.In(time.UTC).Weekday()]
+}
+
+var errFileNotFound = errors.New("file not found")
+
+type record struct {
+	header map[string]int
+	values []string
+}
+
+func (r record) get(column string) string {
+	idx, ok := r.header[column]
+	if !ok || idx >= len(r.values) {
+		return ""
+	}
+	return strings.TrimSpace(r.values[idx])
+}
+
+func readFile(archive *zip.Reader, name string, parse func(record) error) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return errFileNotFound
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.ReuseRecord = true
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	headerRow, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+
+	header := map[string]int{}
+	for i, column := range headerRow {
+		header[strings.TrimSpace(strings.TrimPrefix(column, "\uFEFF"))] = i
+	}
+
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := parse(record{header: header, values: values}); err != nil {
+			return err
+		}
+	}
+}
+
+const noTime int32 = -1
+
+func parseOptionalTime(value string) (int32, error) {
+	if value == "" {
+		return noTime, nil
+	}
+	return parseTime(value)
+}
+
+// parseTime parses GTFS times (HH:MM:SS, possibly beyond 24:00:00) into
+// seconds since the start of the service day.
+func parseTime(value string) (int32, error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+
+	hours, err1 := strconv.Atoi(parts[0])
+	minutes, err2 := strconv.Atoi(parts[1])
+	seconds, err3 := strconv.Atoi(parts[2])
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return 0, fmt.Errorf("invalid time %q: %w", value, err)
+	}
+
+	return int32(hours*3600 + minutes*60 + seconds), nil
+}
+
+func parseDate(value string) (civil.Date, error) {
+	if len(value) != 8 {
+		return civil.Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	return civil.ParseDate(value[0:4] + "-" + value[4:6] + "-" + value[6:8])
+}
@@ -0,0 +1,105 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeed = map[string]string{
+	"agency.txt": "agency_id,agency_name,agency_url,agency_timezone\n" +
+		"BS,Blue Star Ferries,https://www.bluestarferries.com,Europe/Athens\n",
+	"stops.txt": "\uFEFFstop_id,stop_name,stop_lat,stop_lon,parent_station\n" +
+		"PIR,Piraeus,37.9420,23.6465,\n" +
+		"PAR,Paros,37.0856,25.1488,\n" +
+		"NAX,Naxos,37.1061,25.3727,\n",
+	"routes.txt": "route_id,agency_id,route_short_name,route_long_name,route_type\n" +
+		"R1,BS,BS 1,Piraeus - Naxos,1200\n",
+	"trips.txt": "route_id,service_id,trip_id,trip_headsign,shape_id\n" +
+		"R1,WEEKDAY,T1,Naxos,\n" +
+		"R1,WEEKEND,T2,Naxos,\n",
+	"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\n" +
+		"T1,23:30:00,23:30:00,PIR,1\n" +
+		"T1,,,PAR,2\n" +
+		"T1,28:15:00,28:15:00,NAX,3\n" +
+		"T2,07:30:00,07:30:00,PIR,1\n" +
+		"T2,11:30:00,11:40:00,PAR,2\n" +
+		"T2,12:30:00,12:30:00,NAX,3\n",
+	"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+		"WEEKDAY,1,1,1,1,1,0,0,20260601,20260930\n" +
+		"WEEKEND,0,0,0,0,0,1,1,20260601,20260930\n",
+	"calendar_dates.txt": "service_id,date,exception_type\n" +
+		"WEEKDAY,20260615,2\n" +
+		"WEEKEND,20260615,1\n",
+}
+
+func parseTestFeed(t *testing.T) *feed {
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for name, content := range testFeed {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	f, err := parseFeed("ferries", archive)
+	require.NoError(t, err)
+	return f
+}
+
+func TestIsServiceActive(t *testing.T) {
+	f := parseTestFeed(t)
+
+	tuesday := civil.Date{Year: 2026, Month: 6, Day: 16}
+	saturday := civil.Date{Year: 2026, Month: 6, Day: 20}
+	holiday := civil.Date{Year: 2026, Month: 6, Day: 15}
+	afterSeason := civil.Date{Year: 2026, Month: 10, Day: 6}
+
+	assert.True(t, f.isServiceActive("WEEKDAY", tuesday))
+	assert.False(t, f.isServiceActive("WEEKEND", tuesday))
+	assert.True(t, f.isServiceActive("WEEKEND", saturday))
+	assert.False(t, f.isServiceActive("WEEKDAY", holiday))
+	assert.True(t, f.isServiceActive("WEEKEND", holiday))
+	assert.False(t, f.isServiceActive("WEEKDAY", afterSeason))
+}
+
+func TestConvertLegAfterMidnight(t *testing.T) {
+	f := parseTestFeed(t)
+	date := civil.Date{Year: 2026, Month: 6, Day: 16}
+
+	fromIdx, toIdx, ok := f.findSection("T1", nil, nil)
+	require.True(t, ok)
+
+	leg := f.convertLeg(f.trips["T1"], date, fromIdx, toIdx)
+
+	assert.Equal(t, "BS 1", leg.RouteName)
+	assert.Equal(t, "Blue Star Ferries", leg.AgencyName)
+	assert.Equal(t, "FERRY", leg.Type.String())
+	assert.Equal(t, "2026-06-16T23:30:00", leg.DepartureDateTime.String())
+	assert.Equal(t, "2026-06-17T04:15:00", leg.ArrivalDateTime.String())
+	assert.Equal(t, int32(285), leg.DurationInMinutes)
+	assert.Len(t, leg.Stopovers, 3)
+	assert.Equal(t, "2026-06-16T23:30:00", leg.Stopovers[1].ArrivalDateTime.String())
+}
+
+func TestFindSection(t *testing.T) {
+	f := parseTestFeed(t)
+	par, nax, pir := "PAR", "NAX", "PIR"
+
+	fromIdx, toIdx, ok := f.findSection("T2", &par, &nax)
+	assert.True(t, ok)
+	assert.Equal(t, 1, fromIdx)
+	assert.Equal(t, 2, toIdx)
+
+	_, _, ok = f.findSection("T2", &nax, &pir)
+	assert.False(t, ok)
+}
@@ -0,0 +1,431 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/pkg/logger"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
+)
+
+const maxStopResults = 10
+
+// GtfsFeeds imports the configured GTFS static feeds in the background and
+// keeps them indexed in memory.
+type GtfsFeeds struct {
+	feedURLs map[string]string
+	interval time.Duration
+	dataDir  string
+
+	mu    sync.RWMutex
+	feeds map[string]*feed
+}
+
+func New(config config.WebApi) (*GtfsFeeds, error) {
+	dataDir, err := os.MkdirTemp("", "gtfs-")
+	if err != nil {
+		return nil, fmt.Errorf("create temp dir: %w", err)
+	}
+	return &GtfsFeeds{
+		feedURLs: config.GtfsFeedURLs,
+		interval: config.GtfsRefreshInterval,
+		dataDir:  dataDir,
+		feeds:    map[string]*feed{},
+	}, nil
+}
+
+// Start imports all feeds and re-imports them periodically until ctx is
+// cancelled. A feed that fails to import keeps its previous version and does
+// not affect the other feeds.
+func (g *GtfsFeeds) Start(ctx context.Context, log logger.Interface) {
+	if len(g.feedURLs) == 0 {
+		g.cleanup(log)
+		return
+	}
+
+	go func() {
+		defer g.cleanup(log)
+
+		ticker := time.NewTicker(g.interval)
+		defer ticker.Stop()
+
+		for {
+			g.importFeeds(ctx, log)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (g *GtfsFeeds) importFeeds(ctx context.Context, log logger.Interface) {
+	for name := range g.feedURLs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		f, err := g.importFeed(ctx, name)
+		if err != nil {
+			log.Error(fmt.Errorf("gtfs - importFeeds - feed %s: %w", name, err))
+			continue
+		}
+
+		g.mu.Lock()
+		g.feeds[name] = f
+		g.mu.Unlock()
+	}
+}
+
+func (g *GtfsFeeds) cleanup(log logger.Interface) {
+	if err := os.RemoveAll(g.dataDir); err != nil {
+		log.Error(fmt.Errorf("gtfs - cleanup - remove %s: %w", g.dataDir, err))
+	}
+}
+
+func (g *GtfsFeeds) LookupStops(ctx context.Context, query string) ([]entity.TransitStop, error) {
+	feeds, err := g.accessFeeds(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	type match struct {
+		stop   entity.TransitStop
+		prefix bool
+	}
+	var matches []match
+
+	for _, f := range feeds {
+		for _, s := range f.stops {
+			name := strings.ToLower(s.name)
+			if !strings.Contains(name, query) {
+				continue
+			}
+			matches = append(matches, match{
+				stop:   convertStop(f, s),
+				prefix: strings.HasPrefix(name, query),
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].prefix != matches[j].prefix {
+			return matches[i].prefix
+		}
+		return len(matches[i].stop.Name) < len(matches[j].stop.Name)
+	})
+
+	stops := []entity.TransitStop{}
+	for i := 0; i < len(matches) && i < maxStopResults; i++ {
+		stops = append(stops, matches[i].stop)
+	}
+
+	return stops, nil
+}
+
+func (g *GtfsFeeds) RetrieveTrips(ctx context.Context, request request.TransitTrips) ([]entity.TransitLeg, error) {
+	feeds, err := g.accessFeeds(request.Feed)
+	if err != nil {
+		return nil, err
+	}
+
+	legs := []entity.TransitLeg{}
+	for _, f := range feeds {
+		for _, r := range f.routes {
+			if !equalIgnoringWhitespaceAndCase(r.shortName, request.RouteShortName) {
+				continue
+			}
+
+			for _, tripID := range f.tripsByRoute[r.id] {
+				t := f.trips[tripID]
+				if !f.isServiceActive(t.serviceID, request.Date) {
+					continue
+				}
+
+				fromIdx, toIdx, ok := f.findSection(t.id, request.FromStopID, request.ToStopID)
+				if !ok {
+					continue
+				}
+
+				legs = append(legs, f.convertLeg(t, request.Date, fromIdx, toIdx))
+			}
+		}
+	}
+
+	sort.Slice(legs, func(i, j int) bool {
+		return legs[i].DepartureDateTime.Before(legs[j].DepartureDateTime)
+	})
+
+	return legs, nil
+}
+
+func (g *GtfsFeeds) RetrieveLeg(ctx context.Context, request request.TransitLeg) (entity.TransitLeg, error) {
+	feeds, err := g.accessFeeds(&request.Feed)
+	if err != nil {
+		return entity.TransitLeg{}, err
+	}
+	f := feeds[0]
+
+	t, ok := f.trips[request.TripID]
+	if !ok {
+		return entity.TransitLeg{}, entity.NewError(entity.ErrNotFound, fmt.Sprintf("trip %s not found in feed %s", request.TripID, f.name))
+	}
+
+	fromIdx, toIdx, ok := f.findSection(t.id, &request.FromStopID, &request.ToStopID)
+	if !ok {
+		return entity.TransitLeg{}, entity.NewError(entity.ErrNotFound, "stops not served by trip in requested order")
+	}
+
+	return f.convertLeg(t, request.Date, fromIdx, toIdx), nil
+}
+
+func (g *GtfsFeeds) RetrieveShape(ctx context.Context, feedName string, tripID string) (orb.LineString, error) {
+	feeds, err := g.accessFeeds(&feedName)
+	if err != nil {
+		return nil, err
+	}
+	f := feeds[0]
+
+	t, ok := f.trips[tripID]
+	if !ok {
+		return nil, entity.NewError(entity.ErrNotFound, fmt.Sprintf("trip %s not found in feed %s", tripID, f.name))
+	}
+
+	if shape, ok := f.shapes[t.shapeID]; ok && len(shape) > 1 {
+		return shape, nil
+	}
+
+	// fall back to straight lines between the stops
+	lineString := orb.LineString{}
+	for _, st := range f.stopTimes[t.id] {
+		if s, ok := f.stops[st.stopID]; ok {
+			lineString = append(lineString, s.location)
+		}
+	}
+	return lineString, nil
+}
+
+// findSection returns the indices of the boarding and alighting stop within
+// the trip's stop times, matching either the stop or its parent station.
+func (f *feed) findSection(tripID string, fromStopID *string, toStopID *string) (int, int, bool) {
+	stopTimes := f.stopTimes[tripID]
+	if len(stopTimes) < 2 {
+		return 0, 0, false
+	}
+
+	fromIdx := 0
+	if fromStopID != nil {
+		fromIdx = -1
+		for i := 0; i < len(stopTimes)-1; i++ {
+			if f.matchesStop(stopTimes[i].stopID, *fromStopID) {
+				fromIdx = i
+				break
+			}
+		}
+		if fromIdx == -1 {
+			return 0, 0, false
+		}
+	}
+
+	toIdx := len(stopTimes) - 1
+	if toStopID != nil {
+		toIdx = -1
+		for i := fromIdx + 1; i < len(stopTimes); i++ {
+			if f.matchesStop(stopTimes[i].stopID, *toStopID) {
+				toIdx = i
+				break
+			}
+		}
+		if toIdx == -1 {
+			return 0, 0, false
+		}
+	}
+
+	return fromIdx, toIdx, true
+}
+
+func (f *feed) matchesStop(stopID string, requestedID string) bool {
+	return stopID == requestedID || f.stops[stopID].parentStation == requestedID
+}
+
+func (f *feed) convertLeg(t trip, date civil.Date, fromIdx int, toIdx int) entity.TransitLeg {
+	r := f.routes[t.routeID]
+	stopTimes := f.stopTimes[t.id][fromIdx : toIdx+1]
+
+	stopovers := []entity.TransitStopover{}
+	for _, st := range stopTimes {
+		stopovers = append(stopovers, entity.TransitStopover{
+			Stop:              convertStop(f, f.stops[st.stopID]),
+			StopSequence:      uint32(st.sequence),
+			ArrivalDateTime:   serviceDateTime(date, st.arrival),
+			DepartureDateTime: serviceDateTime(date, st.departure),
+		})
+	}
+
+	from := stopTimes[0]
+	to := stopTimes[len(stopTimes)-1]
+
+	routeName := r.shortName
+	if routeName == "" {
+		routeName = r.longName
+	}
+
+	return entity.TransitLeg{
+		Feed:              f.name,
+		TripID:            t.id,
+		RouteID:           r.id,
+		Type:              transportationType(r.routeType),
+		Origin:            stopovers[0].Stop,
+		Destination:       stopovers[len(stopovers)-1].Stop,
+		DepartureDateTime: serviceDateTime(date, from.departure),
+		ArrivalDateTime:   serviceDateTime(date, to.arrival),
+		DurationInMinutes: (to.arrival - from.departure) / 60,
+		Timezone:          f.agency(r.agencyID).timezone,
+		RouteName:         routeName,
+		Headsign:          t.headsign,
+		AgencyName:        f.agency(r.agencyID).name,
+		Stopovers:         stopovers,
+	}
+}
+
+func (f *feed) agency(agencyID string) agency {
+	if a, ok := f.agencies[agencyID]; ok {
+		return a
+	}
+	// agency_id is optional for feeds with a single agency
+	for _, a := range f.agencies {
+		return a
+	}
+	return agency{}
+}
+
+func convertStop(f *feed, s stop) entity.TransitStop {
+	return entity.TransitStop{
+		ID:   s.id,
+		Feed: f.name,
+		Name: s.name,
+		Location: entity.Location{
+			Latitude:  float32(s.location.Lat()),
+			Longitude: float32(s.location.Lon()),
+		},
+	}
+}
+
+func serviceDateTime(date civil.Date, seconds int32) civil.DateTime {
+	midnight := civil.DateTime{Date: date}.In(time.UTC)
+	return civil.DateTimeOf(midnight.Add(time.Duration(seconds) * time.Second))
+}
+
+// transportationType maps basic and extended GTFS route types.
+func transportationType(routeType int) entity.TransportationType {
+	switch {
+	case routeType == 2 || (routeType >= 100 && routeType < 200):
+		return entity.TRAIN
+	case routeType == 3 || (routeType >= 200 && routeType < 300) || (routeType >= 700 && routeType < 800):
+		return entity.BUS
+	case routeType == 4 || (routeType >= 1000 && routeType < 1300):
+		return entity.FERRY
+	default:
+		return entity.OTHER
+	}
+}
+
+// accessFeeds returns the imported feeds without waiting for pending imports.
+// Feeds that have not been imported yet are skipped unless requested by name.
+func (g *GtfsFeeds) accessFeeds(feedName *string) ([]*feed, error) {
+	if feedName != nil {
+		if _, ok := g.feedURLs[*feedName]; !ok {
+			return nil, entity.NewError(entity.ErrInvalidInput, fmt.Sprintf("unknown GTFS feed %s", *feedName))
+		}
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if feedName != nil {
+		f, ok := g.feeds[*feedName]
+		if !ok {
+			return nil, entity.NewError(entity.ErrUpstreamUnavailable, fmt.Sprintf("GTFS feed %s is not imported yet", *feedName))
+		}
+		return []*feed{f}, nil
+	}
+
+	names := []string{}
+	for name := range g.feeds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	feeds := []*feed{}
+	for _, name := range names {
+		feeds = append(feeds, g.feeds[name])
+	}
+
+	return feeds, nil
+}
+
+func (g *GtfsFeeds) importFeed(ctx context.Context, name string) (*feed, error) {
+	filePath := filepath.Join(g.dataDir, name+".zip")
+	if err := g.downloadFeed(ctx, g.feedURLs[name], filePath); err != nil {
+		return nil, fmt.Errorf("download: %w", err)
+	}
+	defer os.Remove(filePath)
+
+	archive, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %w", err)
+	}
+	defer archive.Close()
+
+	return parseFeed(name, &archive.Reader)
+}
+
+func (g *GtfsFeeds) downloadFeed(ctx context.Context, feedURL string, filePath string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", feedURL, nil)
+	if err != nil {
+		return fmt.Errorf("create http request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do http request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	localFile, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer localFile.Close()
+
+	if _, err = io.Copy(localFile, resp.Body); err != nil {
+		return fmt.Errorf("save file: %w", err)
+	}
+
+	return nil
+}
+
+func equalIgnoringWhitespaceAndCase(s, t string) bool {
+	sWithoutWhitespace := strings.ReplaceAll(s, " ", "")
+	tWithoutWhitespace := strings.ReplaceAll(t, " ", "")
+	return strings.EqualFold(sWithoutWhitespace, tWithoutWhitespace)
+}
@@ -0,0 +1,419 @@
+package gtfsrt
+
+import (
+	"fmt"
+	"kompass/internal/entity"
+	"math"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The decoder reads the wire format of gtfs-realtime.proto directly, which
+// spares us a generated copy of the schema. Only the fields we use are read.
+
+// feedMessage holds the decoded entities of one GTFS-Realtime feed.
+type feedMessage struct {
+	tripUpdates []entity.TripUpdate
+	vehicles    []entity.VehiclePosition
+	alerts      []entity.ServiceAlert
+}
+
+type tripDescriptor struct {
+	tripID    string
+	routeID   string
+	startDate *civil.Date
+	cancelled bool
+}
+
+const (
+	tripScheduleRelationshipCanceled = 3
+	stopScheduleRelationshipSkipped  = 1
+)
+
+var causes = map[uint64]string{
+	1:  "UNKNOWN_CAUSE",
+	2:  "OTHER_CAUSE",
+	3:  "TECHNICAL_PROBLEM",
+	4:  "STRIKE",
+	5:  "DEMONSTRATION",
+	6:  "ACCIDENT",
+	7:  "HOLIDAY",
+	8:  "WEATHER",
+	9:  "MAINTENANCE",
+	10: "CONSTRUCTION",
+	11: "POLICE_ACTIVITY",
+	12: "MEDICAL_EMERGENCY",
+}
+
+var effects = map[uint64]string{
+	1:  "NO_SERVICE",
+	2:  "REDUCED_SERVICE",
+	3:  "SIGNIFICANT_DELAYS",
+	4:  "DETOUR",
+	5:  "ADDITIONAL_SERVICE",
+	6:  "MODIFIED_SERVICE",
+	7:  "OTHER_EFFECT",
+	8:  "UNKNOWN_EFFECT",
+	9:  "STOP_MOVED",
+	10: "NO_EFFECT",
+	11: "ACCESSIBILITY_ISSUE",
+}
+
+type field struct {
+	num    protowire.Number
+	typ    protowire.Type
+	varint uint64
+	bytes  []byte
+}
+
+// rangeFields calls fn for each field of the encoded message. Fixed-size
+// values are returned in field.varint.
+func rangeFields(b []byte, fn func(field) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		f := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.varint = uint64(v)
+		case protowire.Fixed64Type:
+			f.varint, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeFeedMessage(b []byte, feedName string) (feedMessage, error) {
+	message := feedMessage{}
+	err := rangeFields(b, func(f field) error {
+		if f.num != 2 || f.typ != protowire.BytesType {
+			return nil
+		}
+		if err := decodeFeedEntity(f.bytes, feedName, &message); err != nil {
+			return fmt.Errorf("decode entity: %w", err)
+		}
+		return nil
+	})
+	return message, err
+}
+
+func decodeFeedEntity(b []byte, feedName string, message *feedMessage) error {
+	var id string
+	var deleted bool
+	var tripUpdate, vehicle, alert []byte
+
+	err := rangeFields(b, func(f field) error {
+		switch f.num {
+		case 1:
+			id = string(f.bytes)
+		case 2:
+			deleted = f.varint != 0
+		case 3:
+			tripUpdate = f.bytes
+		case 4:
+			vehicle = f.bytes
+		case 5:
+			alert = f.bytes
+		}
+		return nil
+	})
+	if err != nil || deleted {
+		return err
+	}
+
+	if tripUpdate != nil {
+		update, err := decodeTripUpdate(tripUpdate)
+		if err != nil {
+			return fmt.Errorf("decode trip update: %w", err)
+		}
+		message.tripUpdates = append(message.tripUpdates, update)
+	}
+	if vehicle != nil {
+		position, err := decodeVehiclePosition(vehicle)
+		if err != nil {
+			return fmt.Errorf("decode vehicle position: %w", err)
+		}
+		message.vehicles = append(message.vehicles, position)
+	}
+	if alert != nil {
+		serviceAlert, err := decodeAlert(alert)
+		if err != nil {
+			return fmt.Errorf("decode alert: %w", err)
+		}
+		serviceAlert.ID = id
+		serviceAlert.Feed = feedName
+		message.alerts = append(message.alerts, serviceAlert)
+	}
+	return nil
+}
+
+func decodeTripDescriptor(b []byte) (tripDescriptor, error) {
+	trip := tripDescriptor{}
+	err := rangeFields(b, func(f field) error {
+		switch f.num {
+		case 1:
+			trip.tripID = string(f.bytes)
+		case 3:
+			date, err := parseDate(string(f.bytes))
+			if err != nil {
+				return err
+			}
+			trip.startDate = &date
+		case 4:
+			trip.cancelled = f.varint == tripScheduleRelationshipCanceled
+		case 5:
+			trip.routeID = string(f.bytes)
+		}
+		return nil
+	})
+	return trip, err
+}
+
+func decodeTripUpdate(b []byte) (entity.TripUpdate, error) {
+	update := entity.TripUpdate{StopTimeUpdates: []entity.StopTimeUpdate{}}
+	err := rangeFields(b, func(f field) error {
+		switch f.num {
+		case 1:
+			trip, err := decodeTripDescriptor(f.bytes)
+			if err != nil {
+				return err
+			}
+			update.TripID = trip.tripID
+			update.StartDate = trip.startDate
+			update.Cancelled = trip.cancelled
+		case 2:
+			stopTimeUpdate, err := decodeStopTimeUpdate(f.bytes)
+			if err != nil {
+				return err
+			}
+			update.StopTimeUpdates = append(update.StopTimeUpdates, stopTimeUpdate)
+		case 4:
+			update.Timestamp = time.Unix(int64(f.varint), 0)
+		case 5:
+			update.Delay = int32Ptr(f.varint)
+		}
+		return nil
+	})
+	return update, err
+}
+
+func decodeStopTimeUpdate(b []byte) (entity.StopTimeUpdate, error) {
+	update := entity.StopTimeUpdate{}
+	err := rangeFields(b, func(f field) error {
+		var err error
+		switch f.num {
+		case 1:
+			sequence := uint32(f.varint)
+			update.StopSequence = &sequence
+		case 2:
+			update.Arrival, err = decodeStopTimeEvent(f.bytes)
+		case 3:
+			update.Departure, err = decodeStopTimeEvent(f.bytes)
+		case 4:
+			update.StopID = string(f.bytes)
+		case 5:
+			update.Skipped = f.varint == stopScheduleRelationshipSkipped
+		}
+		return err
+	})
+	return update, err
+}
+
+func decodeStopTimeEvent(b []byte) (entity.StopTimeEvent, error) {
+	event := entity.StopTimeEvent{}
+	err := rangeFields(b, func(f field) error {
+		switch f.num {
+		case 1:
+			event.Delay = int32Ptr(f.varint)
+		case 2:
+			t := time.Unix(int64(f.varint), 0)
+			event.Time = &t
+		}
+		return nil
+	})
+	return event, err
+}
+
+func decodeVehiclePosition(b []byte) (entity.VehiclePosition, error) {
+	position := entity.VehiclePosition{}
+	err := rangeFields(b, func(f field) error {
+		switch f.num {
+		case 1:
+			trip, err := decodeTripDescriptor(f.bytes)
+			if err != nil {
+				return err
+			}
+			position.TripID = trip.tripID
+		case 2:
+			return rangeFields(f.bytes, func(f field) error {
+				value := math.Float32frombits(uint32(f.varint))
+				switch f.num {
+				case 1:
+					position.Location.Latitude = value
+				case 2:
+					position.Location.Longitude = value
+				case 3:
+					position.Bearing = &value
+				}
+				return nil
+			})
+		case 5:
+			position.Timestamp = time.Unix(int64(f.varint), 0)
+		case 7:
+			stopID := string(f.bytes)
+			position.StopID = &stopID
+		}
+		return nil
+	})
+	return position, err
+}
+
+func decodeAlert(b []byte) (entity.ServiceAlert, error) {
+	alert := entity.ServiceAlert{
+		Cause:         causes[1],
+		Effect:        effects[8],
+		ActivePeriods: []entity.AlertPeriod{},
+		RouteIDs:      []string{},
+		StopIDs:       []string{},
+		TripIDs:       []string{},
+	}
+
+	err := rangeFields(b, func(f field) error {
+		var err error
+		switch f.num {
+		case 1:
+			var period entity.AlertPeriod
+			period, err = decodeTimeRange(f.bytes)
+			alert.ActivePeriods = append(alert.ActivePeriods, period)
+		case 5:
+			err = decodeEntitySelector(f.bytes, &alert)
+		case 6:
+			if cause, ok := causes[f.varint]; ok {
+				alert.Cause = cause
+			}
+		case 7:
+			if effect, ok := effects[f.varint]; ok {
+				alert.Effect = effect
+			}
+		case 8:
+			var url string
+			url, err = decodeTranslatedString(f.bytes)
+			alert.URL = &url
+		case 10:
+			alert.Header, err = decodeTranslatedString(f.bytes)
+		case 11:
+			alert.Description, err = decodeTranslatedString(f.bytes)
+		}
+		return err
+	})
+	return alert, err
+}
+
+func decodeTimeRange(b []byte) (entity.AlertPeriod, error) {
+	period := entity.AlertPeriod{}
+	err := rangeFields(b, func(f field) error {
+		t := time.Unix(int64(f.varint), 0)
+		switch f.num {
+		case 1:
+			period.Start = &t
+		case 2:
+			period.End = &t
+		}
+		return nil
+	})
+	return period, err
+}
+
+func decodeEntitySelector(b []byte, alert *entity.ServiceAlert) error {
+	return rangeFields(b, func(f field) error {
+		switch f.num {
+		case 2:
+			alert.RouteIDs = append(alert.RouteIDs, string(f.bytes))
+		case 4:
+			trip, err := decodeTripDescriptor(f.bytes)
+			if err != nil {
+				return err
+			}
+			if trip.tripID != "" {
+				alert.TripIDs = append(alert.TripIDs, trip.tripID)
+			}
+			if trip.routeID != "" {
+				alert.RouteIDs = append(alert.RouteIDs, trip.routeID)
+			}
+		case 5:
+			alert.StopIDs = append(alert.StopIDs, string(f.bytes))
+		}
+		return nil
+	})
+}
+
+// decodeTranslatedString prefers English or untagged translations and falls
+// back to the first one.
+func decodeTranslatedString(b []byte) (string, error) {
+	var texts []string
+	preferred := -1
+
+	err := rangeFields(b, func(f field) error {
+		if f.num != 1 {
+			return nil
+		}
+		var text, language string
+		err := rangeFields(f.bytes, func(f field) error {
+			switch f.num {
+			case 1:
+				text = string(f.bytes)
+			case 2:
+				language = string(f.bytes)
+			}
+			return nil
+		})
+		if preferred == -1 && (language == "" || language == "en") {
+			preferred = len(texts)
+		}
+		texts = append(texts, text)
+		return err
+	})
+
+	switch {
+	case err != nil || len(texts) == 0:
+		return "", err
+	case preferred != -1:
+		return texts[preferred], nil
+	default:
+		return texts[0], nil
+	}
+}
+
+func int32Ptr(varint uint64) *int32 {
+	value := int32(int64(varint))
+	return &value
+}
+
+func parseDate(value string) (civil.Date, error) {
+	if len(value) != 8 {
+		return civil.Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	return civil.ParseDate(value[0:4] + "-" + value[4:6] + "-" + value[6:8])
+}
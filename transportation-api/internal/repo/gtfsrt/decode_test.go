@@ -0,0 +1,191 @@
+package gtfsrt
+
+import (
+	"kompass/internal/entity"
+	"math"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func appendMessage(b []byte, num protowire.Number, message []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, message)
+}
+
+func appendString(b []byte, num protowire.Number, value string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, value)
+}
+
+func appendVarint(b []byte, num protowire.Number, value uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, value)
+}
+
+func appendFloat(b []byte, num protowire.Number, value float32) []byte {
+	b = protowire.AppendTag(b, num, protowire.Fixed32Type)
+	return protowire.AppendFixed32(b, math.Float32bits(value))
+}
+
+func testFeedMessage() []byte {
+	var trip []byte
+	trip = appendString(trip, 1, "T1")
+	trip = appendString(trip, 3, "20260616")
+	trip = appendString(trip, 5, "R1")
+
+	var departure []byte
+	departure = appendVarint(departure, 1, 300)
+
+	earlier := int64(-120)
+	var arrival []byte
+	arrival = appendVarint(arrival, 1, uint64(earlier))
+
+	var firstStop []byte
+	firstStop = appendString(firstStop, 4, "PIR")
+	firstStop = appendMessage(firstStop, 3, departure)
+
+	var skippedStop []byte
+	skippedStop = appendString(skippedStop, 4, "PAR")
+	skippedStop = appendVarint(skippedStop, 5, 1)
+
+	var lastStop []byte
+	lastStop = appendString(lastStop, 4, "NAX")
+	lastStop = appendMessage(lastStop, 2, arrival)
+
+	var tripUpdate []byte
+	tripUpdate = appendMessage(tripUpdate, 1, trip)
+	tripUpdate = appendMessage(tripUpdate, 2, firstStop)
+	tripUpdate = appendMessage(tripUpdate, 2, skippedStop)
+	tripUpdate = appendMessage(tripUpdate, 2, lastStop)
+
+	var position []byte
+	position = appendFloat(position, 1, 37.5)
+	position = appendFloat(position, 2, 24.25)
+
+	var vehicle []byte
+	vehicle = appendMessage(vehicle, 1, trip)
+	vehicle = appendMessage(vehicle, 2, position)
+
+	var german, english []byte
+	german = appendString(german, 1, "Streik")
+	german = appendString(german, 2, "de")
+	english = appendString(english, 1, "Strike")
+	english = appendString(english, 2, "en")
+
+	var header []byte
+	header = appendMessage(header, 1, german)
+	header = appendMessage(header, 1, english)
+
+	var informedEntity []byte
+	informedEntity = appendString(informedEntity, 5, "PIR")
+
+	var alert []byte
+	alert = appendMessage(alert, 5, informedEntity)
+	alert = appendVarint(alert, 6, 4)
+	alert = appendVarint(alert, 7, 3)
+	alert = appendMessage(alert, 10, header)
+
+	var message []byte
+	for _, entityFields := range [][]byte{
+		appendMessage(appendString(nil, 1, "update"), 3, tripUpdate),
+		appendMessage(appendString(nil, 1, "vehicle"), 4, vehicle),
+		appendMessage(appendString(nil, 1, "alert"), 5, alert),
+	} {
+		message = appendMessage(message, 2, entityFields)
+	}
+	return message
+}
+
+func TestDecodeFeedMessage(t *testing.T) {
+	message, err := decodeFeedMessage(testFeedMessage(), "ferries")
+	require.NoError(t, err)
+
+	require.Len(t, message.tripUpdates, 1)
+	update := message.tripUpdates[0]
+	assert.Equal(t, "T1", update.TripID)
+	assert.Equal(t, civil.Date{Year: 2026, Month: 6, Day: 16}, *update.StartDate)
+	require.Len(t, update.StopTimeUpdates, 3)
+	assert.Equal(t, int32(300), *update.StopTimeUpdates[0].Departure.Delay)
+	assert.True(t, update.StopTimeUpdates[1].Skipped)
+	assert.Equal(t, int32(-120), *update.StopTimeUpdates[2].Arrival.Delay)
+
+	require.Len(t, message.vehicles, 1)
+	assert.Equal(t, entity.Location{Latitude: 37.5, Longitude: 24.25}, message.vehicles[0].Location)
+
+	require.Len(t, message.alerts, 1)
+	assert.Equal(t, "alert", message.alerts[0].ID)
+	assert.Equal(t, "ferries", message.alerts[0].Feed)
+	assert.Equal(t, "STRIKE", message.alerts[0].Cause)
+	assert.Equal(t, "SIGNIFICANT_DELAYS", message.alerts[0].Effect)
+	assert.Equal(t, "Strike", message.alerts[0].Header)
+	assert.Equal(t, []string{"PIR"}, message.alerts[0].StopIDs)
+}
+
+func TestApplyTripUpdate(t *testing.T) {
+	message, err := decodeFeedMessage(testFeedMessage(), "ferries")
+	require.NoError(t, err)
+
+	date := civil.Date{Year: 2026, Month: 6, Day: 16}
+	stopover := func(id string, hour int) entity.TransitStopover {
+		dateTime := civil.DateTime{Date: date, Time: civil.Time{Hour: hour}}
+		return entity.TransitStopover{Stop: entity.TransitStop{ID: id}, ArrivalDateTime: dateTime, DepartureDateTime: dateTime}
+	}
+	leg := entity.TransitLeg{
+		Timezone:  "Europe/Athens",
+		Stopovers: []entity.TransitStopover{stopover("PIR", 7), stopover("PAR", 11), stopover("NAX", 12)},
+	}
+
+	leg.ApplyTripUpdate(message.tripUpdates[0])
+
+	assert.Equal(t, "2026-06-16T07:05:00", leg.RealtimeDepartureDateTime.String())
+	assert.Equal(t, "2026-06-16T11:05:00", leg.Stopovers[1].RealtimeArrivalDateTime.String())
+	assert.True(t, leg.Stopovers[1].Cancelled)
+	assert.Equal(t, "2026-06-16T11:58:00", leg.RealtimeArrivalDateTime.String())
+}
+
+func TestApplyTripUpdateUpstreamDelay(t *testing.T) {
+	message, err := decodeFeedMessage(testFeedMessage(), "ferries")
+	require.NoError(t, err)
+
+	date := civil.Date{Year: 2026, Month: 6, Day: 16}
+	stopover := func(id string, hour int) entity.TransitStopover {
+		dateTime := civil.DateTime{Date: date, Time: civil.Time{Hour: hour}}
+		return entity.TransitStopover{Stop: entity.TransitStop{ID: id}, ArrivalDateTime: dateTime, DepartureDateTime: dateTime}
+	}
+	leg := entity.TransitLeg{
+		Timezone:  "Europe/Athens",
+		Stopovers: []entity.TransitStopover{stopover("PAR", 11), stopover("NAX", 12)},
+	}
+
+	leg.ApplyTripUpdate(message.tripUpdates[0])
+
+	assert.Equal(t, "2026-06-16T11:05:00", leg.RealtimeDepartureDateTime.String())
+	assert.Equal(t, "2026-06-16T11:58:00", leg.RealtimeArrivalDateTime.String())
+}
+
+func TestRetrieveTripUpdate(t *testing.T) {
+	date := civil.Date{Year: 2026, Month: 6, Day: 16}
+	previousDate := date.AddDays(-1)
+	g := &GtfsRealtime{feeds: map[string]feedMessage{
+		"ferries": {tripUpdates: []entity.TripUpdate{
+			{TripID: "T1", StartDate: &previousDate},
+			{TripID: "T1", StartDate: &date},
+			{TripID: "T2", StartDate: &previousDate},
+		}},
+	}}
+
+	update, ok := g.RetrieveTripUpdate(nil, "T1", date, true)
+	require.True(t, ok)
+	assert.Equal(t, date, *update.StartDate)
+
+	_, ok = g.RetrieveTripUpdate(nil, "T2", date, false)
+	assert.False(t, ok)
+
+	update, ok = g.RetrieveTripUpdate(nil, "T2", date, true)
+	require.True(t, ok)
+	assert.Equal(t, previousDate, *update.StartDate)
+}
@@ -0,0 +1,197 @@
+package gtfsrt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/pkg/logger"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+// GtfsRealtime polls the configured GTFS-Realtime feeds and keeps the latest
+// trip updates, vehicle positions and service alerts in memory. Each feed
+// may consist of several URLs, e.g. one per entity type.
+type GtfsRealtime struct {
+	feedURLs map[string][]string
+	interval time.Duration
+
+	mu    sync.RWMutex
+	feeds map[string]feedMessage
+}
+
+func New(config config.WebApi) *GtfsRealtime {
+	feedURLs := map[string][]string{}
+	for name, urls := range config.GtfsRealtimeURLs {
+		feedURLs[name] = strings.Split(urls, "|")
+	}
+
+	return &GtfsRealtime{
+		feedURLs: feedURLs,
+		interval: config.GtfsRealtimeInterval,
+		feeds:    map[string]feedMessage{},
+	}
+}
+
+// Start polls all feeds until the context is cancelled.
+func (g *GtfsRealtime) Start(ctx context.Context, log logger.Interface) {
+	if len(g.feedURLs) == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(g.interval)
+		defer ticker.Stop()
+
+		for {
+			g.pollFeeds(ctx, log)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (g *GtfsRealtime) pollFeeds(ctx context.Context, log logger.Interface) {
+	for name, urls := range g.feedURLs {
+		message := feedMessage{}
+		failed := false
+
+		for _, url := range urls {
+			part, err := fetchFeedMessage(ctx, url, name)
+			if err != nil {
+				log.Error(fmt.Errorf("gtfsrt - pollFeeds - feed %s: %w", name, err))
+				failed = true
+				break
+			}
+			message.tripUpdates = append(message.tripUpdates, part.tripUpdates...)
+			message.vehicles = append(message.vehicles, part.vehicles...)
+			message.alerts = append(message.alerts, part.alerts...)
+		}
+
+		// keep the previous state rather than dropping realtime data on errors
+		if failed {
+			continue
+		}
+
+		g.mu.Lock()
+		g.feeds[name] = message
+		g.mu.Unlock()
+	}
+}
+
+func fetchFeedMessage(ctx context.Context, url string, feedName string) (feedMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return feedMessage{}, fmt.Errorf("create http request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return feedMessage{}, fmt.Errorf("do http request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return feedMessage{}, fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return feedMessage{}, fmt.Errorf("read body: %w", err)
+	}
+
+	return decodeFeedMessage(body, feedName)
+}
+
+// RetrieveTripUpdate returns the update for a trip running on the given
+// service date. For overnight trips, whose legs after midnight belong to the
+// previous service day, the run of the day before is used if there is none
+// starting on the date itself.
+func (g *GtfsRealtime) RetrieveTripUpdate(feed *string, tripID string, date civil.Date, overnight bool) (entity.TripUpdate, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	previousDate := date.AddDays(-1)
+	fallback, found := entity.TripUpdate{}, false
+	for name, message := range g.feeds {
+		if feed != nil && *feed != name {
+			continue
+		}
+		for _, update := range message.tripUpdates {
+			if update.TripID != tripID {
+				continue
+			}
+			if update.StartDate == nil || *update.StartDate == date {
+				return update, true
+			}
+			if overnight && !found && *update.StartDate == previousDate {
+				fallback, found = update, true
+			}
+		}
+	}
+	return fallback, found
+}
+
+func (g *GtfsRealtime) RetrieveVehiclePosition(feed *string, tripID string) (entity.VehiclePosition, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for name, message := range g.feeds {
+		if feed != nil && *feed != name {
+			continue
+		}
+		for _, vehicle := range message.vehicles {
+			if vehicle.TripID == tripID {
+				return vehicle, true
+			}
+		}
+	}
+	return entity.VehiclePosition{}, false
+}
+
+// RetrieveAlerts returns the currently active alerts, optionally restricted
+// to those affecting a route or stop.
+func (g *GtfsRealtime) RetrieveAlerts(feed *string, routeID *string, stopID *string) []entity.ServiceAlert {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	now := time.Now()
+	alerts := []entity.ServiceAlert{}
+	for name, message := range g.feeds {
+		if feed != nil && *feed != name {
+			continue
+		}
+		for _, alert := range message.alerts {
+			if !alert.IsActive(now) {
+				continue
+			}
+			if routeID != nil && !slices.Contains(alert.RouteIDs, *routeID) {
+				continue
+			}
+			if stopID != nil && !slices.Contains(alert.StopIDs, *stopID) {
+				continue
+			}
+			alerts = append(alerts, alert)
+		}
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Feed != alerts[j].Feed {
+			return alerts[i].Feed < alerts[j].Feed
+		}
+		return alerts[i].ID < alerts[j].ID
+	})
+
+	return alerts
+}
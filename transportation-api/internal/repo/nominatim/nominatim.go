@@ -0,0 +1,212 @@
+package nominatim
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/geocoder"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// NominatimWebAPI talks to a Nominatim instance. Nominatim has no focus
+// point, so autocomplete results are ranked by importance only.
+type NominatimWebAPI struct {
+	baseURL string
+}
+
+func New(config config.WebApi) *NominatimWebAPI {
+	return &NominatimWebAPI{
+		baseURL: config.NominatimBaseURL,
+	}
+}
+
+type place struct {
+	Name        string            `json:"name"`
+	DisplayName string            `json:"display_name"`
+	Category    string            `json:"category"`
+	Type        string            `json:"type"`
+	AddressType string            `json:"addresstype"`
+	Latitude    string            `json:"lat"`
+	Longitude   string            `json:"lon"`
+	BoundingBox []string          `json:"boundingbox"`
+	Address     map[string]string `json:"address"`
+}
+
+func (a *NominatimWebAPI) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("limit", "1")
+
+	result, err := a.search(ctx, params)
+	if err != nil {
+		return entity.GeocodeLocation{}, err
+	}
+
+	if len(result) == 0 {
+		return entity.GeocodeLocation{}, entity.NewError(entity.ErrNotFound, "no locations found")
+	}
+	p := convertPlace(result[0])
+
+	return entity.GeocodeLocation{
+		Label:     p.Label,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}, nil
+}
+
+func (a *NominatimWebAPI) AutocompleteLocation(ctx context.Context, request request.Autocomplete) ([]entity.GeocodePlace, error) {
+	params := url.Values{}
+	params.Set("q", request.Text)
+	params.Set("limit", strconv.Itoa(geocoder.ResultSize(request.Size)))
+	if len(request.Countries) > 0 {
+		params.Set("countrycodes", strings.ToLower(strings.Join(request.Countries, ",")))
+	}
+
+	result, err := a.search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	places := []entity.GeocodePlace{}
+	for _, p := range result {
+		places = append(places, convertPlace(p))
+	}
+
+	return geocoder.FilterPlaces(places, request), nil
+}
+
+// ReverseGeocode returns at most one candidate, as Nominatim only resolves
+// the closest object.
+func (a *NominatimWebAPI) ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return a.reverse(ctx, location, url.Values{})
+}
+
+// ReverseGeocodeLocality resolves the closest object at the detail level of
+// towns.
+func (a *NominatimWebAPI) ReverseGeocodeLocality(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	params := url.Values{}
+	params.Set("zoom", "10")
+	return a.reverse(ctx, location, params)
+}
+
+func (a *NominatimWebAPI) reverse(ctx context.Context, location entity.Location, params url.Values) ([]entity.GeocodePlace, error) {
+	params.Set("lat", strconv.FormatFloat(float64(location.Latitude), 'f', -1, 32))
+	params.Set("lon", strconv.FormatFloat(float64(location.Longitude), 'f', -1, 32))
+	params.Set("format", "jsonv2")
+	params.Set("addressdetails", "1")
+	reverseUrl := fmt.Sprintf("%s/reverse?%s", a.baseURL, params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[place](ctx, "GET", reverseUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+
+	places := []entity.GeocodePlace{}
+	if result.Latitude != "" {
+		places = append(places, convertPlace(*result))
+	}
+	geocoder.SetDistances(places, location)
+
+	return places, nil
+}
+
+func (a *NominatimWebAPI) search(ctx context.Context, params url.Values) ([]place, error) {
+	params.Set("format", "jsonv2")
+	params.Set("addressdetails", "1")
+	searchUrl := fmt.Sprintf("%s/search?%s", a.baseURL, params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[[]place](ctx, "GET", searchUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+	return *result, nil
+}
+
+func convertPlace(p place) entity.GeocodePlace {
+	latitude, _ := strconv.ParseFloat(p.Latitude, 32)
+	longitude, _ := strconv.ParseFloat(p.Longitude, 32)
+
+	name := p.Name
+	if name == "" {
+		name = strings.Split(p.DisplayName, ",")[0]
+	}
+
+	var countryCode *string
+	if code, ok := p.Address["country_code"]; ok {
+		upper := strings.ToUpper(code)
+		countryCode = &upper
+	}
+
+	return entity.GeocodePlace{
+		Label:     p.DisplayName,
+		Name:      name,
+		Layer:     layer(p),
+		Latitude:  float32(latitude),
+		Longitude: float32(longitude),
+		Address: entity.GeocodeAddress{
+			CountryCode: countryCode,
+			Country:     addressComponent(p.Address, "country"),
+			Region:      addressComponent(p.Address, "state", "region", "province"),
+			Locality:    addressComponent(p.Address, "city", "town", "village", "hamlet", "municipality"),
+			PostalCode:  addressComponent(p.Address, "postcode"),
+			Street:      addressComponent(p.Address, "road", "pedestrian", "footway"),
+			HouseNumber: addressComponent(p.Address, "house_number"),
+		},
+		BoundingBox: boundingBox(p.BoundingBox),
+	}
+}
+
+// layer maps Nominatim's address types to the Pelias layers used by the API.
+func layer(p place) string {
+	switch p.AddressType {
+	case "country":
+		return "country"
+	case "state", "region", "province":
+		return "region"
+	case "county":
+		return "county"
+	case "city", "town", "village", "hamlet", "municipality":
+		return "locality"
+	case "suburb", "quarter", "neighbourhood":
+		return "neighbourhood"
+	case "postcode":
+		return "postalcode"
+	case "road":
+		return "street"
+	}
+	if p.Category == "place" && p.Type == "house" || p.Category == "building" {
+		return "address"
+	}
+	return "venue"
+}
+
+// boundingBox converts [minLat, maxLat, minLon, maxLon].
+func boundingBox(values []string) []float64 {
+	if len(values) != 4 {
+		return nil
+	}
+
+	parsed := make([]float64, 4)
+	for i, value := range values {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil
+		}
+		parsed[i] = v
+	}
+	return []float64{parsed[2], parsed[0], parsed[3], parsed[1]}
+}
+
+func addressComponent(address map[string]string, keys ...string) *string {
+	for _, key := range keys {
+		if value, ok := address[key]; ok && value != "" {
+			return &value
+		}
+	}
+	return nil
+}
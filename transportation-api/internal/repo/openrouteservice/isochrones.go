@@ -0,0 +1,53 @@
+package openrouteservice
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/repo"
+	"net/http"
+	"sort"
+
+	"github.com/paulmach/orb/geojson"
+)
+
+type isochronesRequest struct {
+	Locations [][2]float32 `json:"locations"`
+	Range     []int        `json:"range"`
+	RangeType string       `json:"range_type"`
+	Units     string       `json:"units,omitempty"`
+}
+
+// LookupIsochrones returns one polygon per centre and range. Each feature has
+// the index of its centre ("group_index"), its range in seconds or meters
+// ("value") and the "rangeType". The largest areas come first, so smaller
+// ones are rendered on top.
+func (a *OpenRouteServiceWebAPI) LookupIsochrones(ctx context.Context, isochrones request.Isochrones) (*geojson.FeatureCollection, error) {
+	profile := getProfileByTransportationType(isochrones.TransportationType)
+	isochronesUrl := fmt.Sprintf("%s/v2/isochrones/%s", a.baseURL, profile)
+
+	body := isochronesRequest{Range: isochrones.Ranges, RangeType: isochrones.RangeType}
+	if isochrones.RangeType == "distance" {
+		body.Units = "m"
+	}
+	for _, location := range isochrones.Locations {
+		body.Locations = append(body.Locations, [2]float32{location.Longitude, location.Latitude})
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", a.apiKey)
+
+	result, err := repo.RequestJsonAndParseJsonBody[geojson.FeatureCollection](ctx, "POST", isochronesUrl, body, header)
+	if err != nil {
+		return nil, fmt.Errorf("requestJsonAndParseJsonBody: %w", err)
+	}
+
+	for _, feature := range result.Features {
+		feature.Properties["rangeType"] = isochrones.RangeType
+	}
+	sort.SliceStable(result.Features, func(i, j int) bool {
+		return result.Features[i].Properties.MustFloat64("value", 0) > result.Features[j].Properties.MustFloat64("value", 0)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package openrouteservice
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"net/http"
+)
+
+type matrixRequest struct {
+	Locations [][2]float32 `json:"locations"`
+	Metrics   []string     `json:"metrics"`
+	Units     string       `json:"units"`
+}
+
+// matrixResponse contains null for pairs without a route.
+type matrixResponse struct {
+	Durations [][]*float64 `json:"durations"`
+	Distances [][]*float64 `json:"distances"`
+}
+
+func (a *OpenRouteServiceWebAPI) LookupMatrix(ctx context.Context, matrix request.Matrix) (entity.Matrix, error) {
+	profile := getProfileByTransportationType(matrix.TransportationType)
+	matrixUrl := fmt.Sprintf("%s/v2/matrix/%s", a.baseURL, profile)
+
+	body := matrixRequest{Metrics: []string{"duration", "distance"}, Units: "m"}
+	for _, location := range matrix.Locations {
+		body.Locations = append(body.Locations, [2]float32{location.Longitude, location.Latitude})
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", a.apiKey)
+
+	result, err := repo.RequestJsonAndParseJsonBody[matrixResponse](ctx, "POST", matrixUrl, body, header)
+	if err != nil {
+		return entity.Matrix{}, fmt.Errorf("requestJsonAndParseJsonBody: %w", err)
+	}
+
+	if len(result.Durations) != len(matrix.Locations) || len(result.Distances) != len(matrix.Locations) {
+		return entity.Matrix{}, fmt.Errorf("unexpected matrix size")
+	}
+
+	return entity.Matrix{
+		DurationsInSeconds: result.Durations,
+		DistancesInMeters:  result.Distances,
+	}, nil
+}
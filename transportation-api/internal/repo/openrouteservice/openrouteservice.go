@@ -4,59 +4,151 @@ import (
 	"context"
 	"fmt"
 	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
-	"net/url"
-
-	"github.com/paulmach/orb"
-	"github.com/paulmach/orb/geojson"
+	"kompass/internal/repo/pelias"
+	"kompass/internal/repo/router"
+	"net/http"
 )
 
+// OpenRouteServiceWebAPI serves directions and, through the ORS hosted
+// Pelias instance, geocoding.
 type OpenRouteServiceWebAPI struct {
+	*pelias.PeliasWebAPI
 	baseURL string
 	apiKey  string
 }
 
 func New(config config.WebApi) *OpenRouteServiceWebAPI {
 	return &OpenRouteServiceWebAPI{
-		baseURL: config.OpenRouteServiceBaseURL,
-		apiKey:  config.OpenRouteServiceApiKey,
+		PeliasWebAPI: pelias.NewWithApiKey(config.OpenRouteServiceBaseURL+"/geocode", config.OpenRouteServiceApiKey),
+		baseURL:      config.OpenRouteServiceBaseURL,
+		apiKey:       config.OpenRouteServiceApiKey,
 	}
 }
 
-func (a *OpenRouteServiceWebAPI) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
-	urlFormat := "%s/geocode/search?api_key=%s&size=1&text=%s"
-	searchUrl := fmt.Sprintf(urlFormat, a.baseURL, a.apiKey, url.QueryEscape(query))
+type directionsRequest struct {
+	Coordinates       [][2]float32       `json:"coordinates"`
+	Units             string             `json:"units,omitempty"`
+	Language          string             `json:"language,omitempty"`
+	Elevation         bool               `json:"elevation"`
+	Instructions      bool               `json:"instructions"`
+	Options           *directionsOptions `json:"options,omitempty"`
+	AlternativeRoutes *alternativeRoutes `json:"alternative_routes,omitempty"`
+}
 
-	result, err := repo.RequestAndParseJsonBody[geojson.FeatureCollection](ctx, "GET", searchUrl, nil)
-	if err != nil {
-		return entity.GeocodeLocation{}, fmt.Errorf("requestAndParseJsonBody: %w", err)
-	}
+type directionsOptions struct {
+	AvoidFeatures []string `json:"avoid_features"`
+}
 
-	if len(result.Features) == 0 {
-		return entity.GeocodeLocation{}, fmt.Errorf("no train stations found")
-	}
-	feature := result.Features[0]
-	point := feature.Geometry.(orb.Point)
-
-	return entity.GeocodeLocation{
-		Label:     feature.Properties["label"].(string),
-		Latitude:  float32(point[1]),
-		Longitude: float32(point[0]),
-	}, nil
+type alternativeRoutes struct {
+	TargetCount int `json:"target_count"`
 }
 
-func (a *OpenRouteServiceWebAPI) LookupDirections(ctx context.Context, start entity.Location, end entity.Location, transportationType entity.TransportationType) (*geojson.FeatureCollection, error) {
-	profile := getProfileByTransportationType(transportationType)
-	urlFormat := "%s/v2/directions/%s?api_key=%s&start=%f,%f&end=%f,%f"
-	directionsUrl := fmt.Sprintf(urlFormat, a.baseURL, profile, a.apiKey, start.Longitude, start.Latitude, end.Longitude, end.Latitude)
+// directionsResponse is parsed without orb, which drops the elevation of
+// 3D coordinates.
+type directionsResponse struct {
+	Features []struct {
+		Geometry struct {
+			Coordinates [][]float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties struct {
+			Summary struct {
+				Distance float64 `json:"distance"`
+				Duration float64 `json:"duration"`
+			} `json:"summary"`
+			Ascent   *float64 `json:"ascent"`
+			Descent  *float64 `json:"descent"`
+			Segments []struct {
+				Steps []struct {
+					Instruction string  `json:"instruction"`
+					Name        string  `json:"name"`
+					Distance    float64 `json:"distance"`
+					Duration    float64 `json:"duration"`
+				} `json:"steps"`
+			} `json:"segments"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+func (a *OpenRouteServiceWebAPI) LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error) {
+	profile := getProfileByTransportationType(directions.TransportationType)
+	directionsUrl := fmt.Sprintf("%s/v2/directions/%s/geojson", a.baseURL, profile)
 
-	featureCollection, err := repo.RequestAndParseJsonBody[geojson.FeatureCollection](ctx, "GET", directionsUrl, nil)
+	body := directionsRequest{Elevation: true, Instructions: true}
+	for _, location := range router.Locations(directions) {
+		body.Coordinates = append(body.Coordinates, [2]float32{location.Longitude, location.Latitude})
+	}
+	if directions.Units != nil {
+		body.Units = *directions.Units
+	}
+	if directions.Language != nil {
+		body.Language = *directions.Language
+	}
+	if avoidFeatures := getAvoidFeatures(directions.Avoid, profile); len(avoidFeatures) > 0 {
+		body.Options = &directionsOptions{AvoidFeatures: avoidFeatures}
+	}
+	if directions.Alternatives != nil && *directions.Alternatives > 1 {
+		body.AlternativeRoutes = &alternativeRoutes{TargetCount: *directions.Alternatives}
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", a.apiKey)
+
+	result, err := repo.RequestJsonAndParseJsonBody[directionsResponse](ctx, "POST", directionsUrl, body, header)
 	if err != nil {
-		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+		return entity.Directions{}, fmt.Errorf("requestJsonAndParseJsonBody: %w", err)
 	}
 
-	return featureCollection, nil
+	// ORS reports distances in the requested units
+	toMeters := 1 / router.ConvertDistance(1, directions.Units)
+
+	routes := []router.Route{}
+	for _, feature := range result.Features {
+		lineString, elevations := router.SplitElevations(feature.Geometry.Coordinates)
+
+		steps := []entity.RouteStep{}
+		for _, segment := range feature.Properties.Segments {
+			for _, step := range segment.Steps {
+				steps = append(steps, entity.RouteStep{
+					Instruction:       step.Instruction,
+					Name:              step.Name,
+					DistanceInMeters:  step.Distance * toMeters,
+					DurationInSeconds: step.Duration,
+				})
+			}
+		}
+
+		routes = append(routes, router.Route{
+			LineString: lineString,
+			Elevations: elevations,
+			Distance:   feature.Properties.Summary.Distance * toMeters,
+			Duration:   feature.Properties.Summary.Duration,
+			Ascent:     feature.Properties.Ascent,
+			Descent:    feature.Properties.Descent,
+			Steps:      steps,
+		})
+	}
+
+	return router.NewDirections(routes, directions.Units), nil
+}
+
+// getAvoidFeatures maps the avoid options of the request. Tollways and
+// highways can only be avoided when driving.
+func getAvoidFeatures(avoid []string, profile string) []string {
+	avoidFeatures := []string{}
+	for _, option := range avoid {
+		switch {
+		case option == "ferries":
+			avoidFeatures = append(avoidFeatures, "ferries")
+		case option == "tolls" && profile == "driving-car":
+			avoidFeatures = append(avoidFeatures, "tollways")
+		case option == "highways" && profile == "driving-car":
+			avoidFeatures = append(avoidFeatures, "highways")
+		}
+	}
+	return avoidFeatures
 }
 
 func getProfileByTransportationType(transportationType entity.TransportationType) string {
@@ -0,0 +1,115 @@
+package openrouteservice
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/poi"
+	"net/http"
+)
+
+// categoryIDs are the ORS POI categories of each category.
+var categoryIDs = map[string][]int{
+	"restaurant":  {570},
+	"supermarket": {518},
+	"pharmacy":    {208},
+	"sight":       {134, 621, 622, 627},
+}
+
+var osmTypes = map[int]string{1: "node", 2: "way", 3: "relation"}
+
+type poisRequest struct {
+	Request  string       `json:"request"`
+	Geometry poisGeometry `json:"geometry"`
+	Filters  struct {
+		CategoryIDs []int `json:"category_ids"`
+	} `json:"filters"`
+	Limit int `json:"limit"`
+}
+
+type poisGeometry struct {
+	BoundingBox [][2]float64 `json:"bbox,omitempty"`
+	GeoJson     *poisPoint   `json:"geojson,omitempty"`
+	Buffer      int          `json:"buffer,omitempty"`
+}
+
+type poisPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float32 `json:"coordinates"`
+}
+
+type poisCategory struct {
+	CategoryName string `json:"category_name"`
+}
+
+type poisResponse struct {
+	Features []struct {
+		Geometry struct {
+			Coordinates [2]float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties struct {
+			OsmID       int64                   `json:"osm_id"`
+			OsmType     int                     `json:"osm_type"`
+			CategoryIDs map[string]poisCategory `json:"category_ids"`
+			OsmTags     map[string]string       `json:"osm_tags"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+func (a *OpenRouteServiceWebAPI) SearchPois(ctx context.Context, pois request.Pois) ([]entity.Poi, error) {
+	body := poisRequest{Request: "pois", Limit: 2000}
+	if bbox := pois.BoundingBox; len(bbox) == 4 {
+		body.Geometry.BoundingBox = [][2]float64{{bbox[0], bbox[1]}, {bbox[2], bbox[3]}}
+	} else {
+		body.Geometry.GeoJson = &poisPoint{Type: "Point", Coordinates: [2]float32{pois.Location.Longitude, pois.Location.Latitude}}
+		body.Geometry.Buffer = poi.Radius(pois)
+	}
+	for _, category := range poi.Categories(pois) {
+		body.Filters.CategoryIDs = append(body.Filters.CategoryIDs, categoryIDs[category]...)
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", a.apiKey)
+
+	result, err := repo.RequestJsonAndParseJsonBody[poisResponse](ctx, "POST", a.baseURL+"/pois", body, header)
+	if err != nil {
+		return nil, fmt.Errorf("requestJsonAndParseJsonBody: %w", err)
+	}
+
+	places := []entity.Poi{}
+	for _, feature := range result.Features {
+		properties := feature.Properties
+		name, ok := properties.OsmTags["name"]
+		if !ok {
+			continue
+		}
+
+		var openingHours *string
+		if hours, ok := properties.OsmTags["opening_hours"]; ok {
+			openingHours = &hours
+		}
+
+		places = append(places, entity.Poi{
+			ID:           fmt.Sprintf("%s/%d", osmTypes[properties.OsmType], properties.OsmID),
+			Name:         name,
+			Category:     poiCategory(properties.CategoryIDs),
+			Latitude:     float32(feature.Geometry.Coordinates[1]),
+			Longitude:    float32(feature.Geometry.Coordinates[0]),
+			OpeningHours: openingHours,
+		})
+	}
+
+	return poi.Finalize(places, pois), nil
+}
+
+func poiCategory(categories map[string]poisCategory) string {
+	for _, c := range categories {
+		switch c.CategoryName {
+		case "restaurant", "supermarket", "pharmacy":
+			return c.CategoryName
+		}
+	}
+	return "sight"
+}
@@ -58,7 +58,7 @@ func (a *OpenTravelData) LookupAirport(iata string) (entity.AirportWithTimezone,
 		return convertAirport(record)
 	}
 
-	return entity.AirportWithTimezone{}, fmt.Errorf("airport [iata=%s] not found in dataset", iata)
+	return entity.AirportWithTimezone{}, entity.NewError(entity.ErrNotFound, fmt.Sprintf("airport %s not found in dataset", iata))
 }
 
 func convertAirport(record []string) (entity.AirportWithTimezone, error) {
@@ -110,7 +110,7 @@ func (a *OpenTravelData) LookupAircraftName(iata string) (string, error) {
 		return convertAircraft(record), nil
 	}
 
-	return "", fmt.Errorf("aicraft [iata=%s] not found in dataset", iata)
+	return "", entity.NewError(entity.ErrNotFound, fmt.Sprintf("aircraft %s not found in dataset", iata))
 }
 
 func convertAircraft(record []string) string {
@@ -142,7 +142,7 @@ func (a *OpenTravelData) LookupAirlineName(iata string) (string, error) {
 		return record[7], nil
 	}
 
-	return "", fmt.Errorf("airline [iata=%s] not found in dataset", iata)
+	return "", entity.NewError(entity.ErrNotFound, fmt.Sprintf("airline %s not found in dataset", iata))
 }
 
 func (a *OpenTravelData) DownloadDatasets() error {
@@ -0,0 +1,157 @@
+package osrm
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/router"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+type OsrmWebAPI struct {
+	baseURL string
+}
+
+func New(config config.WebApi) *OsrmWebAPI {
+	return &OsrmWebAPI{
+		baseURL: config.OsrmBaseURL,
+	}
+}
+
+type step struct {
+	Name     string  `json:"name"`
+	Distance float64 `json:"distance"`
+	Duration float64 `json:"duration"`
+	Maneuver struct {
+		Type     string `json:"type"`
+		Modifier string `json:"modifier"`
+	} `json:"maneuver"`
+}
+
+type routeResponse struct {
+	Code   string `json:"code"`
+	Routes []struct {
+		Distance float64          `json:"distance"`
+		Duration float64          `json:"duration"`
+		Geometry geojson.Geometry `json:"geometry"`
+		Legs     []struct {
+			Steps []step `json:"steps"`
+		} `json:"legs"`
+	} `json:"routes"`
+}
+
+// LookupDirections returns English step instructions, as OSRM only returns
+// the maneuvers. Elevation data isn't available.
+func (a *OsrmWebAPI) LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error) {
+	profile := getProfileByTransportationType(directions.TransportationType)
+
+	coordinates := []string{}
+	for _, location := range router.Locations(directions) {
+		coordinates = append(coordinates, fmt.Sprintf("%f,%f", location.Longitude, location.Latitude))
+	}
+
+	params := url.Values{}
+	params.Set("overview", "full")
+	params.Set("geometries", "geojson")
+	params.Set("steps", "true")
+	if directions.Alternatives != nil && *directions.Alternatives > 1 {
+		params.Set("alternatives", strconv.Itoa(*directions.Alternatives-1))
+	}
+	if exclude := getExcludeClasses(directions.Avoid, profile); len(exclude) > 0 {
+		params.Set("exclude", strings.Join(exclude, ","))
+	}
+	routeUrl := fmt.Sprintf("%s/route/v1/%s/%s?%s", a.baseURL, profile, strings.Join(coordinates, ";"), params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[routeResponse](ctx, "GET", routeUrl, nil)
+	if err != nil {
+		return entity.Directions{}, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+
+	if result.Code != "Ok" || len(result.Routes) == 0 {
+		return entity.Directions{}, entity.NewError(entity.ErrNotFound, fmt.Sprintf("no route found: %s", result.Code))
+	}
+
+	routes := []router.Route{}
+	for _, route := range result.Routes {
+		lineString, ok := route.Geometry.Coordinates.(orb.LineString)
+		if !ok {
+			return entity.Directions{}, fmt.Errorf("unexpected geometry type %s", route.Geometry.Type)
+		}
+
+		steps := []entity.RouteStep{}
+		for _, leg := range route.Legs {
+			for _, s := range leg.Steps {
+				steps = append(steps, entity.RouteStep{
+					Instruction:       instruction(s),
+					Name:              s.Name,
+					DistanceInMeters:  s.Distance,
+					DurationInSeconds: s.Duration,
+				})
+			}
+		}
+
+		routes = append(routes, router.Route{LineString: lineString, Distance: route.Distance, Duration: route.Duration, Steps: steps})
+	}
+
+	return router.NewDirections(routes, directions.Units), nil
+}
+
+func instruction(s step) string {
+	switch s.Maneuver.Type {
+	case "depart":
+		return "Depart" + onto(s.Name)
+	case "arrive":
+		return "Arrive at destination"
+	case "roundabout", "rotary":
+		return "Enter the roundabout" + onto(s.Name)
+	case "":
+		return "Continue" + onto(s.Name)
+	}
+
+	action := strings.ToUpper(s.Maneuver.Type[:1]) + s.Maneuver.Type[1:]
+	if s.Maneuver.Modifier != "" {
+		action += " " + s.Maneuver.Modifier
+	}
+	return action + onto(s.Name)
+}
+
+func onto(name string) string {
+	if name == "" {
+		return ""
+	}
+	return " onto " + name
+}
+
+// getExcludeClasses maps the avoid options to the classes of the default
+// car profile. Other profiles don't define any classes.
+func getExcludeClasses(avoid []string, profile string) []string {
+	if profile != "driving" {
+		return nil
+	}
+
+	classes := map[string]string{"tolls": "toll", "highways": "motorway", "ferries": "ferry"}
+	exclude := []string{}
+	for _, option := range avoid {
+		exclude = append(exclude, classes[option])
+	}
+	return exclude
+}
+
+func getProfileByTransportationType(transportationType entity.TransportationType) string {
+	switch transportationType {
+	case entity.BIKE:
+		return "cycling"
+	case entity.HIKE:
+		return "foot"
+	default:
+		return "driving"
+	}
+}
@@ -0,0 +1,127 @@
+package overpass
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/poi"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// OverpassWebAPI searches OpenStreetMap data through an Overpass instance.
+type OverpassWebAPI struct {
+	baseURL string
+}
+
+func New(config config.WebApi) *OverpassWebAPI {
+	return &OverpassWebAPI{
+		baseURL: config.OverpassBaseURL,
+	}
+}
+
+type element struct {
+	Type   string  `json:"type"`
+	ID     int64   `json:"id"`
+	Lat    float64 `json:"lat"`
+	Lon    float64 `json:"lon"`
+	Center *struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	} `json:"center"`
+	Tags map[string]string `json:"tags"`
+}
+
+type response struct {
+	Elements []element `json:"elements"`
+}
+
+// filters are the OSM tags of each category.
+var filters = map[string][]string{
+	"restaurant":  {`["amenity"="restaurant"]`},
+	"supermarket": {`["shop"="supermarket"]`},
+	"pharmacy":    {`["amenity"="pharmacy"]`},
+	"sight":       {`["tourism"~"^(attraction|museum|viewpoint|artwork|gallery)$"]`, `["historic"~"^(monument|castle|memorial|ruins)$"]`},
+}
+
+func (a *OverpassWebAPI) SearchPois(ctx context.Context, pois request.Pois) ([]entity.Poi, error) {
+	params := url.Values{}
+	params.Set("data", query(pois))
+	searchUrl := fmt.Sprintf("%s/interpreter?%s", a.baseURL, params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[response](ctx, "GET", searchUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+
+	places := []entity.Poi{}
+	for _, e := range result.Elements {
+		name, ok := e.Tags["name"]
+		if !ok {
+			continue
+		}
+
+		lat, lon := e.Lat, e.Lon
+		if e.Center != nil {
+			lat, lon = e.Center.Lat, e.Center.Lon
+		}
+
+		var openingHours *string
+		if hours, ok := e.Tags["opening_hours"]; ok {
+			openingHours = &hours
+		}
+
+		places = append(places, entity.Poi{
+			ID:           fmt.Sprintf("%s/%d", e.Type, e.ID),
+			Name:         name,
+			Category:     category(e.Tags),
+			Latitude:     float32(lat),
+			Longitude:    float32(lon),
+			OpeningHours: openingHours,
+		})
+	}
+
+	return poi.Finalize(places, pois), nil
+}
+
+// query builds an Overpass QL query for all requested categories. Ways and
+// relations are returned with their center.
+func query(pois request.Pois) string {
+	area := fmt.Sprintf("(around:%d,%s,%s)", poi.Radius(pois),
+		formatCoordinate(pois.Location.Latitude), formatCoordinate(pois.Location.Longitude))
+	if bbox := pois.BoundingBox; len(bbox) == 4 {
+		// Overpass expects (south, west, north, east)
+		area = fmt.Sprintf("(%g,%g,%g,%g)", bbox[1], bbox[0], bbox[3], bbox[2])
+	}
+
+	var b strings.Builder
+	b.WriteString("[out:json][timeout:25];(")
+	for _, c := range poi.Categories(pois) {
+		for _, filter := range filters[c] {
+			b.WriteString("nwr" + filter + area + ";")
+		}
+	}
+	b.WriteString(");out center tags;")
+	return b.String()
+}
+
+func category(tags map[string]string) string {
+	switch {
+	case tags["amenity"] == "restaurant":
+		return "restaurant"
+	case tags["shop"] == "supermarket":
+		return "supermarket"
+	case tags["amenity"] == "pharmacy":
+		return "pharmacy"
+	default:
+		return "sight"
+	}
+}
+
+func formatCoordinate(coordinate float32) string {
+	return strconv.FormatFloat(float64(coordinate), 'f', -1, 32)
+}
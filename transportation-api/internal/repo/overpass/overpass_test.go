@@ -0,0 +1,28 @@
+package overpass
+
+import (
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	radius := 300
+	pois := request.Pois{
+		Location:   entity.Location{Latitude: 52.5163, Longitude: 13.3777},
+		Radius:     &radius,
+		Categories: []string{"pharmacy"},
+	}
+	assert.Equal(t, `[out:json][timeout:25];(nwr["amenity"="pharmacy"](around:300,52.5163,13.3777););out center tags;`, query(pois))
+
+	pois.BoundingBox = []float64{13.37, 52.51, 13.39, 52.52}
+	assert.Contains(t, query(pois), `nwr["amenity"="pharmacy"](52.51,13.37,52.52,13.39);`)
+}
+
+func TestCategory(t *testing.T) {
+	assert.Equal(t, "restaurant", category(map[string]string{"amenity": "restaurant"}))
+	assert.Equal(t, "supermarket", category(map[string]string{"shop": "supermarket"}))
+	assert.Equal(t, "sight", category(map[string]string{"tourism": "museum"}))
+}
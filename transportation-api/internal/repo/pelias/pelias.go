@@ -0,0 +1,175 @@
+package pelias
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/geocoder"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// PeliasWebAPI talks to a Pelias geocoder. The hosted ORS geocoder is a
+// Pelias instance as well, so it is reused by the ORS adapter.
+type PeliasWebAPI struct {
+	baseURL string
+	apiKey  string
+}
+
+func New(config config.WebApi) *PeliasWebAPI {
+	return NewWithApiKey(config.PeliasBaseURL+"/v1", "")
+}
+
+func NewWithApiKey(baseURL string, apiKey string) *PeliasWebAPI {
+	return &PeliasWebAPI{
+		baseURL: baseURL,
+		apiKey:  apiKey,
+	}
+}
+
+func (a *PeliasWebAPI) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
+	params := url.Values{}
+	params.Set("size", "1")
+	params.Set("text", query)
+
+	result, err := a.request(ctx, "search", params)
+	if err != nil {
+		return entity.GeocodeLocation{}, err
+	}
+
+	if len(result.Features) == 0 {
+		return entity.GeocodeLocation{}, entity.NewError(entity.ErrNotFound, "no locations found")
+	}
+	feature := result.Features[0]
+	point := feature.Geometry.(orb.Point)
+
+	return entity.GeocodeLocation{
+		Label:     feature.Properties.MustString("label", ""),
+		Latitude:  float32(point[1]),
+		Longitude: float32(point[0]),
+	}, nil
+}
+
+func (a *PeliasWebAPI) AutocompleteLocation(ctx context.Context, request request.Autocomplete) ([]entity.GeocodePlace, error) {
+	params := url.Values{}
+	params.Set("text", request.Text)
+	params.Set("size", strconv.Itoa(geocoder.ResultSize(request.Size)))
+	if request.Focus != nil {
+		params.Set("focus.point.lat", formatCoordinate(request.Focus.Latitude))
+		params.Set("focus.point.lon", formatCoordinate(request.Focus.Longitude))
+	}
+	if len(request.Countries) > 0 {
+		params.Set("boundary.country", strings.Join(request.Countries, ","))
+	}
+	if len(request.Layers) > 0 {
+		params.Set("layers", strings.Join(request.Layers, ","))
+	}
+
+	result, err := a.request(ctx, "autocomplete", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPlaces(result), nil
+}
+
+func (a *PeliasWebAPI) ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return a.reverse(ctx, location, url.Values{})
+}
+
+func (a *PeliasWebAPI) ReverseGeocodeLocality(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	params := url.Values{}
+	params.Set("layers", "locality")
+	return a.reverse(ctx, location, params)
+}
+
+func (a *PeliasWebAPI) reverse(ctx context.Context, location entity.Location, params url.Values) ([]entity.GeocodePlace, error) {
+	params.Set("point.lat", formatCoordinate(location.Latitude))
+	params.Set("point.lon", formatCoordinate(location.Longitude))
+	params.Set("size", strconv.Itoa(geocoder.ResultSize(nil)))
+
+	result, err := a.request(ctx, "reverse", params)
+	if err != nil {
+		return nil, err
+	}
+
+	places := convertPlaces(result)
+	geocoder.SortByDistance(places)
+	return places, nil
+}
+
+func (a *PeliasWebAPI) request(ctx context.Context, endpoint string, params url.Values) (*geojson.FeatureCollection, error) {
+	if a.apiKey != "" {
+		params.Set("api_key", a.apiKey)
+	}
+	requestUrl := fmt.Sprintf("%s/%s?%s", a.baseURL, endpoint, params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[geojson.FeatureCollection](ctx, "GET", requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+	return result, nil
+}
+
+func convertPlaces(result *geojson.FeatureCollection) []entity.GeocodePlace {
+	places := []entity.GeocodePlace{}
+	for _, feature := range result.Features {
+		places = append(places, convertPlace(feature))
+	}
+	return places
+}
+
+func convertPlace(feature *geojson.Feature) entity.GeocodePlace {
+	point, _ := feature.Geometry.(orb.Point)
+
+	var boundingBox []float64
+	if len(feature.BBox) == 4 {
+		boundingBox = feature.BBox
+	}
+
+	return entity.GeocodePlace{
+		Label:     feature.Properties.MustString("label", ""),
+		Name:      feature.Properties.MustString("name", ""),
+		Layer:     feature.Properties.MustString("layer", ""),
+		Latitude:  float32(point.Lat()),
+		Longitude: float32(point.Lon()),
+		Address: entity.GeocodeAddress{
+			CountryCode: optionalProperty(feature.Properties, "country_code"),
+			Country:     optionalProperty(feature.Properties, "country"),
+			Region:      optionalProperty(feature.Properties, "region"),
+			Locality:    optionalProperty(feature.Properties, "locality"),
+			PostalCode:  optionalProperty(feature.Properties, "postalcode"),
+			Street:      optionalProperty(feature.Properties, "street"),
+			HouseNumber: optionalProperty(feature.Properties, "housenumber"),
+		},
+		BoundingBox:          boundingBox,
+		DistanceInKilometers: optionalNumberProperty(feature.Properties, "distance"),
+	}
+}
+
+func optionalNumberProperty(properties geojson.Properties, key string) *float64 {
+	value, ok := properties[key].(float64)
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func optionalProperty(properties geojson.Properties, key string) *string {
+	value, ok := properties[key].(string)
+	if !ok || value == "" {
+		return nil
+	}
+	return &value
+}
+
+func formatCoordinate(coordinate float32) string {
+	return strconv.FormatFloat(float64(coordinate), 'f', -1, 32)
+}
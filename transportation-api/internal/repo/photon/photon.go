@@ -0,0 +1,214 @@
+package photon
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/geocoder"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// PhotonWebAPI talks to a Photon instance. Photon can't filter by country,
+// so the filters are applied to the returned candidates.
+type PhotonWebAPI struct {
+	baseURL string
+}
+
+func New(config config.WebApi) *PhotonWebAPI {
+	return &PhotonWebAPI{
+		baseURL: config.PhotonBaseURL,
+	}
+}
+
+func (a *PhotonWebAPI) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("limit", "1")
+
+	result, err := a.request(ctx, "api", params)
+	if err != nil {
+		return entity.GeocodeLocation{}, err
+	}
+
+	if len(result.Features) == 0 {
+		return entity.GeocodeLocation{}, entity.NewError(entity.ErrNotFound, "no locations found")
+	}
+	p := convertPlace(result.Features[0])
+
+	return entity.GeocodeLocation{
+		Label:     p.Label,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}, nil
+}
+
+func (a *PhotonWebAPI) AutocompleteLocation(ctx context.Context, request request.Autocomplete) ([]entity.GeocodePlace, error) {
+	params := url.Values{}
+	params.Set("q", request.Text)
+	params.Set("limit", strconv.Itoa(geocoder.ResultSize(request.Size)))
+	if request.Focus != nil {
+		params.Set("lat", formatCoordinate(request.Focus.Latitude))
+		params.Set("lon", formatCoordinate(request.Focus.Longitude))
+	}
+
+	result, err := a.request(ctx, "api", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return geocoder.FilterPlaces(convertPlaces(result), request), nil
+}
+
+func (a *PhotonWebAPI) ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	return a.reverse(ctx, location, url.Values{})
+}
+
+func (a *PhotonWebAPI) ReverseGeocodeLocality(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	params := url.Values{}
+	params.Set("layer", "city")
+	return a.reverse(ctx, location, params)
+}
+
+func (a *PhotonWebAPI) reverse(ctx context.Context, location entity.Location, params url.Values) ([]entity.GeocodePlace, error) {
+	params.Set("lat", formatCoordinate(location.Latitude))
+	params.Set("lon", formatCoordinate(location.Longitude))
+	params.Set("limit", strconv.Itoa(geocoder.ResultSize(nil)))
+
+	result, err := a.request(ctx, "reverse", params)
+	if err != nil {
+		return nil, err
+	}
+
+	places := convertPlaces(result)
+	geocoder.SetDistances(places, location)
+	geocoder.SortByDistance(places)
+	return places, nil
+}
+
+func (a *PhotonWebAPI) request(ctx context.Context, endpoint string, params url.Values) (*geojson.FeatureCollection, error) {
+	requestUrl := fmt.Sprintf("%s/%s?%s", a.baseURL, endpoint, params.Encode())
+
+	result, err := repo.RequestAndParseJsonBody[geojson.FeatureCollection](ctx, "GET", requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+	return result, nil
+}
+
+func convertPlaces(result *geojson.FeatureCollection) []entity.GeocodePlace {
+	places := []entity.GeocodePlace{}
+	for _, feature := range result.Features {
+		places = append(places, convertPlace(feature))
+	}
+	return places
+}
+
+func convertPlace(feature *geojson.Feature) entity.GeocodePlace {
+	point, _ := feature.Geometry.(orb.Point)
+	properties := feature.Properties
+
+	var countryCode *string
+	if code := properties.MustString("countrycode", ""); code != "" {
+		upper := strings.ToUpper(code)
+		countryCode = &upper
+	}
+
+	address := entity.GeocodeAddress{
+		CountryCode: countryCode,
+		Country:     optionalProperty(properties, "country"),
+		Region:      optionalProperty(properties, "state"),
+		Locality:    optionalProperty(properties, "city"),
+		PostalCode:  optionalProperty(properties, "postcode"),
+		Street:      optionalProperty(properties, "street"),
+		HouseNumber: optionalProperty(properties, "housenumber"),
+	}
+
+	name := properties.MustString("name", "")
+	if name == "" && address.Street != nil {
+		name = *address.Street
+		if address.HouseNumber != nil {
+			name += " " + *address.HouseNumber
+		}
+	}
+
+	return entity.GeocodePlace{
+		Label:       label(name, address),
+		Name:        name,
+		Layer:       layer(properties.MustString("type", "")),
+		Latitude:    float32(point.Lat()),
+		Longitude:   float32(point.Lon()),
+		Address:     address,
+		BoundingBox: boundingBox(properties),
+	}
+}
+
+// label resembles the labels of Pelias, as Photon doesn't provide any.
+func label(name string, address entity.GeocodeAddress) string {
+	parts := []string{}
+	for _, part := range []*string{&name, address.Locality, address.Country} {
+		if part != nil && *part != "" && (len(parts) == 0 || parts[len(parts)-1] != *part) {
+			parts = append(parts, *part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// layer maps Photon's object types to the Pelias layers used by the API.
+func layer(objectType string) string {
+	switch objectType {
+	case "house":
+		return "address"
+	case "street":
+		return "street"
+	case "city", "locality":
+		return "locality"
+	case "district":
+		return "neighbourhood"
+	case "county":
+		return "county"
+	case "state":
+		return "region"
+	case "country":
+		return "country"
+	default:
+		return "venue"
+	}
+}
+
+// boundingBox converts Photon's extent [minLon, maxLat, maxLon, minLat].
+func boundingBox(properties geojson.Properties) []float64 {
+	extent, ok := properties["extent"].([]interface{})
+	if !ok || len(extent) != 4 {
+		return nil
+	}
+
+	values := make([]float64, 4)
+	for i, value := range extent {
+		v, ok := value.(float64)
+		if !ok {
+			return nil
+		}
+		values[i] = v
+	}
+	return []float64{values[0], values[3], values[2], values[1]}
+}
+
+func optionalProperty(properties geojson.Properties, key string) *string {
+	value, ok := properties[key].(string)
+	if !ok || value == "" {
+		return nil
+	}
+	return &value
+}
+
+func formatCoordinate(coordinate float32) string {
+	return strconv.FormatFloat(float64(coordinate), 'f', -1, 32)
+}
@@ -0,0 +1,58 @@
+package poi
+
+import (
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+const (
+	defaultRadius     = 500
+	defaultResultSize = 20
+)
+
+var allCategories = []string{"restaurant", "supermarket", "pharmacy", "sight"}
+
+func Radius(pois request.Pois) int {
+	if pois.Radius == nil {
+		return defaultRadius
+	}
+	return *pois.Radius
+}
+
+// Categories returns the requested categories, or all if none were given.
+func Categories(pois request.Pois) []string {
+	if len(pois.Categories) == 0 {
+		return allCategories
+	}
+	return pois.Categories
+}
+
+// Finalize sets the distances to the location of the request, for backends
+// which don't report them, and returns the closest places first.
+func Finalize(places []entity.Poi, pois request.Pois) []entity.Poi {
+	from := orb.Point{float64(pois.Location.Longitude), float64(pois.Location.Latitude)}
+	for i := range places {
+		to := orb.Point{float64(places[i].Longitude), float64(places[i].Latitude)}
+		places[i].DistanceInMeters = geo.Distance(from, to)
+	}
+
+	sort.SliceStable(places, func(i, j int) bool {
+		return places[i].DistanceInMeters < places[j].DistanceInMeters
+	})
+
+	if size := ResultSize(pois.Size); len(places) > size {
+		places = places[:size]
+	}
+	return places
+}
+
+func ResultSize(size *int) int {
+	if size == nil {
+		return defaultResultSize
+	}
+	return *size
+}
@@ -0,0 +1,56 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is used for carrier-grade NAT and is not routable
+// on the internet, like private addresses.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+var ErrPrivateAddress = errors.New("address is not public")
+
+// NewPublicClient returns a client for URLs chosen by API clients, like
+// webhooks and push subscription endpoints. It only connects to public
+// addresses, which is checked after DNS resolution for every address
+// connected to, and doesn't follow redirects, as they could lead to
+// addresses which were not checked.
+func NewPublicClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: publicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// connections have to be made to the hosts to check their addresses
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// IsPublic excludes loopback, link-local, multicast and unspecified
+// addresses as well as private networks.
+func IsPublic(address netip.Addr) bool {
+	address = address.Unmap()
+	return address.IsGlobalUnicast() && !address.IsPrivate() && !sharedAddressSpace.Contains(address)
+}
+
+func publicOnly(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse address %s: %w", address, err)
+	}
+	if !IsPublic(addrPort.Addr()) {
+		return fmt.Errorf("connect to %s: %w", address, ErrPrivateAddress)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package railprovider
+
+// uicCountryCodes maps UIC country codes, which prefix IBNR/UIC station IDs,
+// to ISO 3166-1 alpha-2 country codes.
+var uicCountryCodes = map[string]string{
+	"10": "FI",
+	"20": "RU",
+	"21": "BY",
+	"22": "UA",
+	"24": "LT",
+	"25": "LV",
+	"26": "EE",
+	"51": "PL",
+	"53": "RO",
+	"54": "CZ",
+	"55": "HU",
+	"56": "SK",
+	"70": "GB",
+	"71": "ES",
+	"73": "GR",
+	"74": "SE",
+	"76": "NO",
+	"78": "HR",
+	"79": "SI",
+	"80": "DE",
+	"81": "AT",
+	"82": "LU",
+	"83": "IT",
+	"84": "NL",
+	"85": "CH",
+	"86": "DK",
+	"87": "FR",
+	"88": "BE",
+	"94": "PT",
+}
+
+// StationCountry returns the country of a UIC station ID like "8100002"
+// or an empty string if the ID does not follow the UIC scheme.
+func StationCountry(stationID string) string {
+	if len(stationID) != 7 {
+		return ""
+	}
+	return uicCountryCodes[stationID[:2]]
+}
@@ -0,0 +1,242 @@
+package railprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/cache"
+	"kompass/internal/repo/dbvendo"
+	"slices"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/civil"
+	"golang.org/x/sync/semaphore"
+)
+
+const DefaultProvider = "db"
+
+// maxConcurrentLookups bounds the secondary journey and polyline lookups of
+// all requests, as each merge may page through several journey requests.
+const maxConcurrentLookups = 4
+
+// RailProviders routes train requests to one of several FPTF-compatible
+// backends (hafas-rest-api, db-vendo, ...) and fills in data missing from
+// the primary provider with results of the provider serving the leg's country.
+type RailProviders struct {
+	providers map[string]repo.DbVendoWebAPI
+	// names lists the default provider first, then the others by name
+	names     []string
+	countries map[string]string
+	routes    cache.Store
+	limit     *semaphore.Weighted
+}
+
+func New(config config.WebApi, defaultProvider repo.DbVendoWebAPI) *RailProviders {
+	providers := map[string]repo.DbVendoWebAPI{
+		DefaultProvider: defaultProvider,
+	}
+	for name, baseURL := range config.RailProviderBaseURLs {
+		providers[strings.ToLower(name)] = dbvendo.NewWithBaseURL(baseURL)
+	}
+
+	countries := map[string]string{}
+	for country, name := range config.RailProviderCountries {
+		countries[strings.ToUpper(country)] = strings.ToLower(name)
+	}
+
+	return newRailProviders(providers, countries)
+}
+
+func newRailProviders(providers map[string]repo.DbVendoWebAPI, countries map[string]string) *RailProviders {
+	names := []string{}
+	for name := range providers {
+		if name != DefaultProvider {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return &RailProviders{
+		providers: providers,
+		names:     append([]string{DefaultProvider}, names...),
+		countries: countries,
+		routes:    cache.NewLRU(routeCapacity),
+		limit:     semaphore.NewWeighted(maxConcurrentLookups),
+	}
+}
+
+// LookupTrainStation asks the other providers if the default provider
+// doesn't know the station.
+func (p *RailProviders) LookupTrainStation(ctx context.Context, query string) (entity.TrainStation, error) {
+	return firstFound(p, func(provider repo.DbVendoWebAPI) (entity.TrainStation, error) {
+		return provider.LookupTrainStation(ctx, query)
+	})
+}
+
+// RetrieveTrip asks the other providers if the default provider doesn't
+// know the trip.
+func (p *RailProviders) RetrieveTrip(ctx context.Context, trainNumber string, date civil.Date) (entity.TrainTrip, error) {
+	return firstFound(p, func(provider repo.DbVendoWebAPI) (entity.TrainTrip, error) {
+		return provider.RetrieveTrip(ctx, trainNumber, date)
+	})
+}
+
+func (p *RailProviders) RetrieveJourney(ctx context.Context, request request.Train) (entity.Train, error) {
+	name, err := p.selectProvider(request)
+	if err != nil {
+		return entity.Train{}, err
+	}
+
+	train, err := p.providers[name].RetrieveJourney(ctx, request)
+	if err != nil {
+		return entity.Train{}, fmt.Errorf("provider %s: %w", name, err)
+	}
+
+	sources := make([]*legSource, len(train.Legs))
+	var wg sync.WaitGroup
+	for i := range train.Legs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sources[i] = p.mergeLeg(ctx, name, i, &train.Legs[i])
+		}()
+	}
+	wg.Wait()
+
+	p.saveRoute(ctx, train.RefreshToken, newRoute(name, sources))
+
+	return train, nil
+}
+
+// RetrievePolylines completes legs without polyline with the polylines of
+// the providers their data was merged from.
+func (p *RailProviders) RetrievePolylines(ctx context.Context, refreshToken string) ([]entity.TrainPolyline, []entity.TrainPolyline, error) {
+	r := p.lookupRoute(ctx, refreshToken)
+
+	provider, ok := p.providers[r.Provider]
+	if !ok {
+		return nil, nil, entity.NewError(entity.ErrInvalidInput, fmt.Sprintf("unknown rail provider %s", r.Provider))
+	}
+
+	legs, transfers, err := provider.RetrievePolylines(ctx, refreshToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wg sync.WaitGroup
+	for _, source := range r.Sources {
+		secondary, ok := p.providers[source.Provider]
+		if !ok || source.Leg >= len(legs) || len(legs[source.Leg].Polyline.Features) > 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := p.limit.Acquire(ctx, 1); err != nil {
+				return
+			}
+			defer p.limit.Release(1)
+
+			// backfilling is best effort, the leg is still drawn by its stations
+			polylines, _, err := secondary.RetrievePolylines(ctx, source.RefreshToken)
+			if err == nil && source.Index < len(polylines) {
+				legs[source.Leg].Polyline = polylines[source.Index].Polyline
+			}
+		}()
+	}
+	wg.Wait()
+
+	return legs, transfers, nil
+}
+
+func (p *RailProviders) selectProvider(request request.Train) (string, error) {
+	if request.Provider != nil {
+		name := strings.ToLower(*request.Provider)
+		if _, ok := p.providers[name]; !ok {
+			return "", entity.NewError(entity.ErrInvalidInput, fmt.Sprintf("unknown rail provider %s", name))
+		}
+		return name, nil
+	}
+
+	return p.providerByStation(request.FromStationID), nil
+}
+
+func (p *RailProviders) providerByStation(stationID string) string {
+	if name, ok := p.countries[StationCountry(stationID)]; ok {
+		if _, ok := p.providers[name]; ok {
+			return name
+		}
+	}
+	return DefaultProvider
+}
+
+// mergeLeg completes a leg with data of the provider serving the leg's country
+// if the primary provider left out the operator or platforms. The journey of
+// that provider is returned, so its polyline can be used if the primary
+// provider has none.
+func (p *RailProviders) mergeLeg(ctx context.Context, primary string, index int, leg *entity.TrainLeg) *legSource {
+	secondary := p.providerByStation(leg.Origin.ID)
+	if secondary == primary {
+		secondary = p.providerByStation(leg.Destination.ID)
+	}
+	if secondary == primary {
+		return nil
+	}
+
+	if err := p.limit.Acquire(ctx, 1); err != nil {
+		return nil
+	}
+	defer p.limit.Release(1)
+
+	train, err := p.providers[secondary].RetrieveJourney(ctx, request.Train{
+		FromStationID: leg.Origin.ID,
+		ToStationID:   leg.Destination.ID,
+		TrainNumbers:  []string{leg.LineName},
+		DepartureDate: leg.DepartureDateTime.Date,
+	})
+	if err != nil {
+		// merging is best effort, the primary result is still valid
+		return nil
+	}
+
+	for i, candidate := range train.Legs {
+		if candidate.DepartureDateTime != leg.DepartureDateTime {
+			continue
+		}
+
+		if leg.OperatorName == "" {
+			leg.OperatorName = candidate.OperatorName
+		}
+		if leg.DeparturePlatform == nil {
+			leg.DeparturePlatform = candidate.DeparturePlatform
+		}
+		if leg.ArrivalPlatform == nil {
+			leg.ArrivalPlatform = candidate.ArrivalPlatform
+		}
+		if len(leg.Stopovers) == 0 {
+			leg.Stopovers = candidate.Stopovers
+		}
+		return &legSource{Leg: index, Provider: secondary, Index: i, RefreshToken: train.RefreshToken}
+	}
+	return nil
+}
+
+// firstFound tries the providers in order until one knows the requested
+// station or trip. Other errors are returned right away.
+func firstFound[T any](p *RailProviders, lookup func(provider repo.DbVendoWebAPI) (T, error)) (T, error) {
+	var result T
+	var err error
+	for _, name := range p.names {
+		result, err = lookup(p.providers[name])
+		if err == nil || !errors.Is(err, entity.ErrNotFound) {
+			break
+		}
+	}
+	return result, err
+}
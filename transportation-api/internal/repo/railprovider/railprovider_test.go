@@ -0,0 +1,112 @@
+package railprovider
+
+import (
+	"context"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	train     entity.Train
+	polylines []entity.TrainPolyline
+	station   *entity.TrainStation
+	tokens    []string
+}
+
+func (f *fakeProvider) LookupTrainStation(context.Context, string) (entity.TrainStation, error) {
+	if f.station == nil {
+		return entity.TrainStation{}, entity.NewError(entity.ErrNotFound, "no station found")
+	}
+	return *f.station, nil
+}
+
+func (f *fakeProvider) RetrieveJourney(context.Context, request.Train) (entity.Train, error) {
+	return f.train, nil
+}
+
+func (f *fakeProvider) RetrievePolylines(_ context.Context, refreshToken string) ([]entity.TrainPolyline, []entity.TrainPolyline, error) {
+	f.tokens = append(f.tokens, refreshToken)
+	return f.polylines, nil, nil
+}
+
+func (f *fakeProvider) RetrieveTrip(context.Context, string, civil.Date) (entity.TrainTrip, error) {
+	return entity.TrainTrip{}, nil
+}
+
+func TestBackfillPolylines(t *testing.T) {
+	departure := civil.DateTime{Date: civil.Date{Year: 2025, Month: 9, Day: 20}, Time: civil.Time{Hour: 8}}
+	domestic := entity.TrainLeg{Origin: entity.TrainStation{ID: "8011113"}, Destination: entity.TrainStation{ID: "8000261"}, LineName: "ICE 707", DepartureDateTime: departure}
+	foreign := entity.TrainLeg{Origin: entity.TrainStation{ID: "8000261"}, Destination: entity.TrainStation{ID: "8100108"}, LineName: "RJX 63", DepartureDateTime: departure}
+
+	lines := geojson.NewFeatureCollection()
+	lines.Append(geojson.NewFeature(orb.Point{11.5, 48.1}))
+	lines.Append(geojson.NewFeature(orb.Point{13.0, 47.8}))
+
+	db := &fakeProvider{
+		train: entity.Train{RefreshToken: "db-token", Legs: []entity.TrainLeg{domestic, foreign}},
+		polylines: []entity.TrainPolyline{
+			{OriginID: "8011113", DestinationID: "8000261", Polyline: *lines},
+			{OriginID: "8000261", DestinationID: "8100108", Polyline: *geojson.NewFeatureCollection()},
+		},
+	}
+	oebb := &fakeProvider{
+		train:     entity.Train{RefreshToken: "oebb-token", Legs: []entity.TrainLeg{{DepartureDateTime: departure, OperatorName: "ÖBB"}}},
+		polylines: []entity.TrainPolyline{{Polyline: *lines}},
+	}
+	p := newRailProviders(map[string]repo.DbVendoWebAPI{DefaultProvider: db, "oebb": oebb}, map[string]string{"AT": "oebb"})
+
+	train, err := p.RetrieveJourney(context.Background(), request.Train{FromStationID: "8011113"})
+	require.NoError(t, err)
+	assert.Equal(t, "db-token", train.RefreshToken)
+	assert.Equal(t, "", train.Legs[0].OperatorName)
+	assert.Equal(t, "ÖBB", train.Legs[1].OperatorName)
+
+	legs, _, err := p.RetrievePolylines(context.Background(), train.RefreshToken)
+	require.NoError(t, err)
+	assert.Len(t, legs[1].Polyline.Features, 2)
+	assert.Equal(t, "8100108", legs[1].DestinationID)
+	assert.Equal(t, []string{"oebb-token"}, oebb.tokens)
+}
+
+func TestRouteByProvider(t *testing.T) {
+	db := &fakeProvider{train: entity.Train{RefreshToken: "db-token"}}
+	oebb := &fakeProvider{train: entity.Train{RefreshToken: "oebb-token"}}
+	p := newRailProviders(map[string]repo.DbVendoWebAPI{DefaultProvider: db, "oebb": oebb}, map[string]string{"AT": "oebb"})
+
+	train, err := p.RetrieveJourney(context.Background(), request.Train{FromStationID: "8100108"})
+	require.NoError(t, err)
+	assert.Equal(t, "oebb-token", train.RefreshToken)
+
+	_, _, err = p.RetrievePolylines(context.Background(), train.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oebb-token"}, oebb.tokens)
+	assert.Empty(t, db.tokens)
+
+	// unknown tokens are the default provider's
+	_, _, err = p.RetrievePolylines(context.Background(), "oebb-token|provider=oebb")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oebb-token|provider=oebb"}, db.tokens)
+}
+
+func TestLookupTrainStationFallback(t *testing.T) {
+	wien := entity.TrainStation{ID: "8100003", Name: "Wien Hbf"}
+	db := &fakeProvider{}
+	oebb := &fakeProvider{station: &wien}
+	p := newRailProviders(map[string]repo.DbVendoWebAPI{DefaultProvider: db, "oebb": oebb}, nil)
+
+	station, err := p.LookupTrainStation(context.Background(), "Wien Hbf")
+	require.NoError(t, err)
+	assert.Equal(t, wien, station)
+
+	oebb.station = nil
+	_, err = p.LookupTrainStation(context.Background(), "Wien Hbf")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+}
@@ -0,0 +1,64 @@
+package railprovider
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Routes are kept until the polylines were retrieved, which happens right
+// after the journey. The polylines are cached along with the journey.
+const (
+	routeCapacity = 10000
+	routeTTL      = time.Hour
+)
+
+// route is how a journey was served. Routes are kept server-side by the
+// refresh token of the primary provider, so that the token clients see stays
+// the provider's own and can't be used to choose providers or tokens.
+type route struct {
+	Provider string      `json:"provider"`
+	Sources  []legSource `json:"sources"`
+}
+
+// legSource is the leg of another provider's journey which a leg was merged
+// with, so polylines can be backfilled without looking up the journeys again.
+type legSource struct {
+	Leg          int    `json:"leg"`
+	Provider     string `json:"provider"`
+	Index        int    `json:"index"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+func newRoute(provider string, sources []*legSource) route {
+	r := route{Provider: provider}
+	for _, source := range sources {
+		if source != nil {
+			r.Sources = append(r.Sources, *source)
+		}
+	}
+	return r
+}
+
+func (p *RailProviders) saveRoute(ctx context.Context, refreshToken string, r route) {
+	if r.Provider == DefaultProvider && len(r.Sources) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return
+	}
+	_ = p.routes.Set(ctx, refreshToken, data, routeTTL)
+}
+
+// lookupRoute falls back to the default provider without sources for
+// journeys this process didn't retrieve.
+func (p *RailProviders) lookupRoute(ctx context.Context, refreshToken string) route {
+	r := route{Provider: DefaultProvider}
+	data, found, err := p.routes.Get(ctx, refreshToken)
+	if err == nil && found {
+		_ = json.Unmarshal(data, &r)
+	}
+	return r
+}
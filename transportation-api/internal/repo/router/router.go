@@ -0,0 +1,239 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/paulmach/orb/geojson"
+)
+
+// Table dispatches direction requests to a router per transportation type.
+type Table struct {
+	routes        map[entity.TransportationType]repo.Router
+	defaultRouter repo.Router
+}
+
+// New builds the routing table from the configured router names. Types
+// without an entry are routed by the default router.
+func New(routes map[string]string, defaultRouter string, available map[string]repo.Router) (*Table, error) {
+	table := &Table{routes: map[entity.TransportationType]repo.Router{}}
+
+	r, ok := available[defaultRouter]
+	if !ok {
+		return nil, fmt.Errorf("unknown router %s", defaultRouter)
+	}
+	table.defaultRouter = r
+
+	for transportationType, name := range routes {
+		if !entity.TransportationType(transportationType).IsValid() {
+			return nil, fmt.Errorf("unknown transportation type %s", transportationType)
+		}
+		r, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown router %s for %s", name, transportationType)
+		}
+		table.routes[entity.TransportationType(transportationType)] = r
+	}
+
+	return table, nil
+}
+
+func (t *Table) LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error) {
+	r, ok := t.routes[directions.TransportationType]
+	if !ok {
+		r = t.defaultRouter
+	}
+	return r.LookupDirections(ctx, directions)
+}
+
+const elevationSamples = 100
+
+// Route is a single route as returned by a router, with distances in meters
+// and durations in seconds. Elevations are optional and given per point of
+// the LineString.
+type Route struct {
+	LineString orb.LineString
+	Elevations []float64
+	Distance   float64
+	Duration   float64
+	Ascent     *float64
+	Descent    *float64
+	Steps      []entity.RouteStep
+}
+
+// NewDirections normalises routes to the format of the ORS directions API,
+// with one LineString per alternative with distance and duration (s) in its
+// summary, and adds the typed summaries. Only the distance of the GeoJSON
+// is converted to the requested units.
+func NewDirections(routes []Route, units *string) entity.Directions {
+	directions := entity.Directions{
+		Routes:  []entity.Route{},
+		GeoJson: geojson.NewFeatureCollection(),
+	}
+
+	for i, route := range routes {
+		feature := geojson.NewFeature(route.LineString)
+		feature.Properties["alternative"] = i
+		feature.Properties["summary"] = map[string]interface{}{
+			"distance": ConvertDistance(route.Distance, units),
+			"duration": route.Duration,
+		}
+		directions.GeoJson.Append(feature)
+
+		steps := route.Steps
+		if steps == nil {
+			steps = []entity.RouteStep{}
+		}
+
+		summary := entity.Route{
+			DistanceInMeters:  route.Distance,
+			DurationInSeconds: route.Duration,
+			AscentInMeters:    route.Ascent,
+			DescentInMeters:   route.Descent,
+			ElevationProfile:  ElevationProfile(route.LineString, route.Elevations, elevationSamples),
+			Steps:             steps,
+		}
+		if len(route.Elevations) == len(route.LineString) && summary.AscentInMeters == nil {
+			summary.AscentInMeters, summary.DescentInMeters = climb(route.Elevations)
+		}
+		directions.Routes = append(directions.Routes, summary)
+	}
+
+	return directions
+}
+
+// ElevationProfile samples the elevation at equidistant points along the
+// LineString, interpolating between its points.
+func ElevationProfile(lineString orb.LineString, elevations []float64, samples int) []entity.ElevationPoint {
+	profile := []entity.ElevationPoint{}
+	if len(elevations) != len(lineString) || len(lineString) < 2 || samples < 2 {
+		return profile
+	}
+
+	cumulative := make([]float64, len(lineString))
+	for i := 1; i < len(lineString); i++ {
+		cumulative[i] = cumulative[i-1] + geo.Distance(lineString[i-1], lineString[i])
+	}
+	total := cumulative[len(cumulative)-1]
+
+	idx := 0
+	for i := 0; i < samples; i++ {
+		distance := total * float64(i) / float64(samples-1)
+		for idx < len(cumulative)-2 && cumulative[idx+1] < distance {
+			idx++
+		}
+
+		elevation := elevations[idx]
+		if segment := cumulative[idx+1] - cumulative[idx]; segment > 0 {
+			fraction := math.Min(1, (distance-cumulative[idx])/segment)
+			elevation += fraction * (elevations[idx+1] - elevations[idx])
+		}
+
+		profile = append(profile, entity.ElevationPoint{
+			DistanceInMeters:  distance,
+			ElevationInMeters: elevation,
+		})
+	}
+
+	return profile
+}
+
+func climb(elevations []float64) (*float64, *float64) {
+	var ascent, descent float64
+	for i := 1; i < len(elevations); i++ {
+		if diff := elevations[i] - elevations[i-1]; diff > 0 {
+			ascent += diff
+		} else {
+			descent -= diff
+		}
+	}
+	return &ascent, &descent
+}
+
+// SplitElevations separates the elevation of 3D coordinates, as orb only
+// supports 2D points.
+func SplitElevations(coordinates [][]float64) (orb.LineString, []float64) {
+	lineString := orb.LineString{}
+	elevations := []float64{}
+	for _, coordinate := range coordinates {
+		if len(coordinate) < 2 {
+			continue
+		}
+		lineString = append(lineString, orb.Point{coordinate[0], coordinate[1]})
+		if len(coordinate) > 2 {
+			elevations = append(elevations, coordinate[2])
+		}
+	}
+	if len(elevations) != len(lineString) {
+		elevations = nil
+	}
+	return lineString, elevations
+}
+
+// ConvertDistance converts meters to the units of a directions request.
+func ConvertDistance(meters float64, units *string) float64 {
+	if units == nil {
+		return meters
+	}
+	switch *units {
+	case "km":
+		return meters / 1000
+	case "mi":
+		return meters / 1609.344
+	default:
+		return meters
+	}
+}
+
+// Locations returns start, waypoints and end of a directions request.
+func Locations(directions request.Directions) []entity.Location {
+	locations := []entity.Location{directions.Start}
+	locations = append(locations, directions.Waypoints...)
+	return append(locations, directions.End)
+}
+
+// DecodePolyline decodes an encoded polyline with the given precision, e.g.
+// 6 for Valhalla.
+func DecodePolyline(encoded string, precision int) (orb.LineString, error) {
+	factor := 1.0
+	for i := 0; i < precision; i++ {
+		factor *= 10
+	}
+
+	lineString := orb.LineString{}
+	var latitude, longitude int
+	for idx := 0; idx < len(encoded); {
+		var deltas [2]int
+		for i := range deltas {
+			var result, shift int
+			for {
+				if idx >= len(encoded) {
+					return nil, fmt.Errorf("truncated polyline")
+				}
+				b := int(encoded[idx]) - 63
+				idx++
+				result |= (b & 0x1f) << shift
+				shift += 5
+				if b < 0x20 {
+					break
+				}
+			}
+			if result&1 != 0 {
+				deltas[i] = ^(result >> 1)
+			} else {
+				deltas[i] = result >> 1
+			}
+		}
+		latitude += deltas[0]
+		longitude += deltas[1]
+		lineString = append(lineString, orb.Point{float64(longitude) / factor, float64(latitude) / factor})
+	}
+
+	return lineString, nil
+}
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"kompass/internal/repo"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePolyline(t *testing.T) {
+	// example of the polyline algorithm documentation
+	lineString, err := DecodePolyline("_p~iF~ps|U_ulLnnqC_mqNvxq`@", 5)
+	require.NoError(t, err)
+
+	expected := orb.LineString{{-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}}
+	require.Len(t, lineString, len(expected))
+	for i := range expected {
+		assert.InDelta(t, expected[i].Lon(), lineString[i].Lon(), 1e-9)
+		assert.InDelta(t, expected[i].Lat(), lineString[i].Lat(), 1e-9)
+	}
+
+	_, err = DecodePolyline("_p~iF~ps|U_ulL", 5)
+	assert.Error(t, err)
+}
+
+func TestElevationProfile(t *testing.T) {
+	lineString, elevations := SplitElevations([][]float64{{13.0, 52.0, 100}, {13.0, 52.01, 200}, {13.0, 52.02, 150}})
+	require.Len(t, elevations, 3)
+
+	profile := ElevationProfile(lineString, elevations, 5)
+
+	require.Len(t, profile, 5)
+	assert.InDelta(t, 0, profile[0].DistanceInMeters, 1e-9)
+	assert.InDelta(t, 100, profile[0].ElevationInMeters, 1e-9)
+	assert.InDelta(t, 150, profile[1].ElevationInMeters, 1e-6)
+	assert.InDelta(t, 200, profile[2].ElevationInMeters, 1e-6)
+	assert.InDelta(t, 150, profile[4].ElevationInMeters, 1e-6)
+
+	directions := NewDirections([]Route{{LineString: lineString, Elevations: elevations, Distance: 2224}}, nil)
+	assert.Equal(t, 100.0, *directions.Routes[0].AscentInMeters)
+	assert.Equal(t, 50.0, *directions.Routes[0].DescentInMeters)
+}
+
+func TestNew(t *testing.T) {
+	available := map[string]repo.Router{"ors": nil, "sea": nil}
+
+	_, err := New(map[string]string{"FERRY": "sea"}, "ors", available)
+	assert.NoError(t, err)
+
+	_, err = New(map[string]string{"SHIP": "sea"}, "ors", available)
+	assert.ErrorContains(t, err, "unknown transportation type SHIP")
+
+	_, err = New(map[string]string{"FERRY": "osrm"}, "ors", available)
+	assert.ErrorContains(t, err, "unknown router osrm")
+}
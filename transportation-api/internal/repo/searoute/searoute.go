@@ -0,0 +1,226 @@
+package searoute
+
+import (
+	"container/heap"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo/router"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+//go:embed sealanes.json
+var seaLanes []byte
+
+const (
+	// maxSnapDistance is the maximum distance (m) between a location and the
+	// closest node of the sea lane graph.
+	maxSnapDistance = 50_000
+	// ferryCorridorFactor makes known ferry corridors slightly cheaper, so
+	// they are preferred over open sea lanes of similar length.
+	ferryCorridorFactor = 0.9
+	ferrySpeed          = 35 / 3.6
+	boatSpeed           = 15 / 3.6
+)
+
+type graphFile struct {
+	Nodes []struct {
+		ID       string     `json:"id"`
+		Location [2]float64 `json:"location"`
+	} `json:"nodes"`
+	Edges []struct {
+		From  string `json:"from"`
+		To    string `json:"to"`
+		Ferry bool   `json:"ferry"`
+	} `json:"edges"`
+}
+
+type edge struct {
+	to   int
+	cost float64
+}
+
+// SeaRouter computes maritime routes offline on a bundled graph of sea
+// lanes. The lanes only run through open water and connect the major ferry
+// ports of Europe, so the routes don't cross any coastline.
+type SeaRouter struct {
+	points    []orb.Point
+	adjacency [][]edge
+}
+
+func New() (*SeaRouter, error) {
+	return parse(seaLanes)
+}
+
+func parse(data []byte) (*SeaRouter, error) {
+	var file graphFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("unmarshal sea lanes: %w", err)
+	}
+
+	r := &SeaRouter{}
+	index := map[string]int{}
+	for i, node := range file.Nodes {
+		index[node.ID] = i
+		r.points = append(r.points, orb.Point(node.Location))
+	}
+
+	r.adjacency = make([][]edge, len(r.points))
+	for _, e := range file.Edges {
+		from, ok := index[e.From]
+		if !ok {
+			return nil, fmt.Errorf("unknown node %s", e.From)
+		}
+		to, ok := index[e.To]
+		if !ok {
+			return nil, fmt.Errorf("unknown node %s", e.To)
+		}
+
+		cost := geo.Distance(r.points[from], r.points[to])
+		if e.Ferry {
+			cost *= ferryCorridorFactor
+		}
+		r.adjacency[from] = append(r.adjacency[from], edge{to: to, cost: cost})
+		r.adjacency[to] = append(r.adjacency[to], edge{to: from, cost: cost})
+	}
+
+	return r, nil
+}
+
+// LookupDirections returns a single route via all waypoints. Avoid options
+// and alternatives don't apply at sea and are ignored.
+func (r *SeaRouter) LookupDirections(_ context.Context, directions request.Directions) (entity.Directions, error) {
+	locations := router.Locations(directions)
+
+	route := router.Route{LineString: orb.LineString{}}
+	for i := 1; i < len(locations); i++ {
+		lineString, distance, err := r.shortestPath(toPoint(locations[i-1]), toPoint(locations[i]))
+		if err != nil {
+			return entity.Directions{}, err
+		}
+		if len(route.LineString) > 0 {
+			lineString = lineString[1:]
+		}
+		route.LineString = append(route.LineString, lineString...)
+		route.Distance += distance
+	}
+	route.Duration = route.Distance / speed(directions.TransportationType)
+
+	return router.NewDirections([]router.Route{route}, directions.Units), nil
+}
+
+func (r *SeaRouter) shortestPath(start, end orb.Point) (orb.LineString, float64, error) {
+	from, err := r.snap(start)
+	if err != nil {
+		return nil, 0, err
+	}
+	to, err := r.snap(end)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	path, ok := r.dijkstra(from, to)
+	if !ok {
+		return nil, 0, entity.NewError(entity.ErrNotFound, "no sea route found")
+	}
+
+	lineString := orb.LineString{start}
+	for _, node := range path {
+		lineString = append(lineString, r.points[node])
+	}
+	lineString = append(lineString, end)
+
+	return lineString, geo.Length(lineString), nil
+}
+
+// snap returns the closest node of the graph, which usually is the port
+// itself.
+func (r *SeaRouter) snap(point orb.Point) (int, error) {
+	closest, closestDistance := -1, math.Inf(1)
+	for i, p := range r.points {
+		if distance := geo.Distance(point, p); distance < closestDistance {
+			closest, closestDistance = i, distance
+		}
+	}
+
+	if closest < 0 || closestDistance > maxSnapDistance {
+		return 0, entity.NewError(entity.ErrNotFound, fmt.Sprintf("no sea lane near %f,%f", point.Lat(), point.Lon()))
+	}
+	return closest, nil
+}
+
+func (r *SeaRouter) dijkstra(from, to int) ([]int, bool) {
+	costs := make([]float64, len(r.points))
+	previous := make([]int, len(r.points))
+	for i := range costs {
+		costs[i] = math.Inf(1)
+		previous[i] = -1
+	}
+	costs[from] = 0
+
+	queue := &priorityQueue{{node: from}}
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(queueItem)
+		if current.node == to {
+			break
+		}
+		if current.cost > costs[current.node] {
+			continue
+		}
+
+		for _, e := range r.adjacency[current.node] {
+			if cost := current.cost + e.cost; cost < costs[e.to] {
+				costs[e.to] = cost
+				previous[e.to] = current.node
+				heap.Push(queue, queueItem{node: e.to, cost: cost})
+			}
+		}
+	}
+
+	if math.IsInf(costs[to], 1) {
+		return nil, false
+	}
+
+	path := []int{}
+	for node := to; node != -1; node = previous[node] {
+		path = append([]int{node}, path...)
+	}
+	return path, true
+}
+
+func speed(transportationType entity.TransportationType) float64 {
+	if transportationType == entity.BOAT {
+		return boatSpeed
+	}
+	return ferrySpeed
+}
+
+func toPoint(location entity.Location) orb.Point {
+	return orb.Point{float64(location.Longitude), float64(location.Latitude)}
+}
+
+type queueItem struct {
+	node int
+	cost float64
+}
+
+type priorityQueue []queueItem
+
+func (q priorityQueue) Len() int           { return len(q) }
+func (q priorityQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q priorityQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *priorityQueue) Push(x any) { *q = append(*q, x.(queueItem)) }
+
+func (q *priorityQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,58 @@
+package searoute
+
+import (
+	"context"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortestPath(t *testing.T) {
+	r, err := parse([]byte(`{
+		"nodes": [
+			{"id": "a", "location": [0, 0]},
+			{"id": "b", "location": [1, 0]},
+			{"id": "c", "location": [0.5, 1]},
+			{"id": "d", "location": [2, 0]}
+		],
+		"edges": [
+			{"from": "a", "to": "c"},
+			{"from": "c", "to": "b"},
+			{"from": "b", "to": "d"}
+		]
+	}`))
+	require.NoError(t, err)
+
+	lineString, distance, err := r.shortestPath(orb.Point{0, 0.01}, orb.Point{2, 0.01})
+	require.NoError(t, err)
+	assert.Equal(t, orb.LineString{{0, 0.01}, {0, 0}, {0.5, 1}, {1, 0}, {2, 0}, {2, 0.01}}, lineString)
+	assert.Greater(t, distance, 0.0)
+
+	_, _, err = r.shortestPath(orb.Point{0, 0}, orb.Point{10, 10})
+	assert.Error(t, err)
+}
+
+func TestBundledSeaLanes(t *testing.T) {
+	r, err := New()
+	require.NoError(t, err)
+
+	piraeus := entity.Location{Latitude: 37.94, Longitude: 23.62}
+	heraklion := entity.Location{Latitude: 35.34, Longitude: 25.14}
+	directions, err := r.LookupDirections(context.Background(), request.Directions{
+		Start:              piraeus,
+		End:                heraklion,
+		TransportationType: entity.FERRY,
+	})
+	require.NoError(t, err)
+	require.Len(t, directions.Routes, 1)
+	assert.InDelta(t, 330_000, directions.Routes[0].DistanceInMeters, 50_000)
+
+	kiel := entity.Location{Latitude: 54.33, Longitude: 10.15}
+	oslo := entity.Location{Latitude: 59.9, Longitude: 10.74}
+	_, err = r.LookupDirections(context.Background(), request.Directions{Start: kiel, End: oslo, TransportationType: entity.FERRY})
+	assert.NoError(t, err)
+}
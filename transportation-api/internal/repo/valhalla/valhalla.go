@@ -0,0 +1,149 @@
+package valhalla
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/repo/router"
+
+	"github.com/paulmach/orb"
+)
+
+type ValhallaWebAPI struct {
+	baseURL string
+}
+
+func New(config config.WebApi) *ValhallaWebAPI {
+	return &ValhallaWebAPI{
+		baseURL: config.ValhallaBaseURL,
+	}
+}
+
+type location struct {
+	Latitude  float32 `json:"lat"`
+	Longitude float32 `json:"lon"`
+}
+
+type routeRequest struct {
+	Locations         []location                        `json:"locations"`
+	Costing           string                            `json:"costing"`
+	CostingOptions    map[string]map[string]interface{} `json:"costing_options,omitempty"`
+	Alternates        int                               `json:"alternates,omitempty"`
+	DirectionsOptions map[string]string                 `json:"directions_options"`
+}
+
+type trip struct {
+	Legs []struct {
+		Shape     string `json:"shape"`
+		Maneuvers []struct {
+			Instruction string   `json:"instruction"`
+			StreetNames []string `json:"street_names"`
+			Length      float64  `json:"length"`
+			Time        float64  `json:"time"`
+		} `json:"maneuvers"`
+	} `json:"legs"`
+	Summary struct {
+		Length float64 `json:"length"`
+		Time   float64 `json:"time"`
+	} `json:"summary"`
+}
+
+type routeResponse struct {
+	Trip       trip `json:"trip"`
+	Alternates []struct {
+		Trip trip `json:"trip"`
+	} `json:"alternates"`
+}
+
+// LookupDirections doesn't return elevation data, which Valhalla only
+// provides through a separate endpoint.
+func (a *ValhallaWebAPI) LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error) {
+	costing := getCostingByTransportationType(directions.TransportationType)
+
+	body := routeRequest{
+		Costing:           costing,
+		DirectionsOptions: map[string]string{"units": "kilometers"},
+	}
+	if directions.Language != nil {
+		body.DirectionsOptions["language"] = *directions.Language
+	}
+	for _, l := range router.Locations(directions) {
+		body.Locations = append(body.Locations, location{Latitude: l.Latitude, Longitude: l.Longitude})
+	}
+	if options := getCostingOptions(directions.Avoid); len(options) > 0 {
+		body.CostingOptions = map[string]map[string]interface{}{costing: options}
+	}
+	if directions.Alternatives != nil && *directions.Alternatives > 1 {
+		body.Alternates = *directions.Alternatives - 1
+	}
+
+	result, err := repo.RequestJsonAndParseJsonBody[routeResponse](ctx, "POST", a.baseURL+"/route", body, nil)
+	if err != nil {
+		return entity.Directions{}, fmt.Errorf("requestJsonAndParseJsonBody: %w", err)
+	}
+
+	trips := []trip{result.Trip}
+	for _, alternate := range result.Alternates {
+		trips = append(trips, alternate.Trip)
+	}
+
+	routes := []router.Route{}
+	for _, t := range trips {
+		lineString := orb.LineString{}
+		steps := []entity.RouteStep{}
+		for _, leg := range t.Legs {
+			shape, err := router.DecodePolyline(leg.Shape, 6)
+			if err != nil {
+				return entity.Directions{}, fmt.Errorf("decode shape: %w", err)
+			}
+			lineString = append(lineString, shape...)
+
+			for _, maneuver := range leg.Maneuvers {
+				step := entity.RouteStep{
+					Instruction:       maneuver.Instruction,
+					DistanceInMeters:  maneuver.Length * 1000,
+					DurationInSeconds: maneuver.Time,
+				}
+				if len(maneuver.StreetNames) > 0 {
+					step.Name = maneuver.StreetNames[0]
+				}
+				steps = append(steps, step)
+			}
+		}
+		routes = append(routes, router.Route{
+			LineString: lineString,
+			Distance:   t.Summary.Length * 1000,
+			Duration:   t.Summary.Time,
+			Steps:      steps,
+		})
+	}
+
+	return router.NewDirections(routes, directions.Units), nil
+}
+
+// getCostingOptions maps the avoid options to the penalties of Valhalla,
+// where 0 means the feature is avoided as far as possible.
+func getCostingOptions(avoid []string) map[string]interface{} {
+	names := map[string]string{"tolls": "use_tolls", "highways": "use_highways", "ferries": "use_ferry"}
+	options := map[string]interface{}{}
+	for _, option := range avoid {
+		options[names[option]] = 0
+	}
+	return options
+}
+
+func getCostingByTransportationType(transportationType entity.TransportationType) string {
+	switch transportationType {
+	case entity.BIKE:
+		return "bicycle"
+	case entity.HIKE:
+		return "pedestrian"
+	case entity.BUS:
+		return "bus"
+	default:
+		return "auto"
+	}
+}
@@ -0,0 +1,157 @@
+package watchstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/entity"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const lockTimeout = 5 * time.Second
+
+var watchesBucket = []byte("watches")
+
+// WatchStore persists watches in an embedded bbolt database. The database is
+// only opened for the duration of a transaction, so that the processes of
+// prefork mode can share it; bbolt's file lock serialises them.
+type WatchStore struct {
+	path string
+
+	mu sync.Mutex
+}
+
+func New(config config.Watch) (*WatchStore, error) {
+	if err := os.MkdirAll(filepath.Dir(config.StorePath), 0o755); err != nil {
+		return nil, fmt.Errorf("create watch store directory: %w", err)
+	}
+
+	s := &WatchStore{path: config.StorePath}
+	err := s.update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(watchesBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create watch store: %w", err)
+	}
+	return s, nil
+}
+
+func (s *WatchStore) CreateWatch(_ context.Context, watch entity.Watch) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(watchesBucket)
+		if bucket.Get([]byte(watch.ID)) != nil {
+			return fmt.Errorf("watch %s already exists", watch.ID)
+		}
+		return putWatch(bucket, watch)
+	})
+}
+
+func (s *WatchStore) UpdateWatch(_ context.Context, watch entity.Watch) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(watchesBucket)
+		if bucket.Get([]byte(watch.ID)) == nil {
+			return entity.NewError(entity.ErrNotFound, "no watch found")
+		}
+		return putWatch(bucket, watch)
+	})
+}
+
+func (s *WatchStore) RetrieveWatch(_ context.Context, id string) (entity.Watch, error) {
+	var watch entity.Watch
+	err := s.view(func(tx *bolt.Tx) error {
+		data := tx.Bucket(watchesBucket).Get([]byte(id))
+		if data == nil {
+			return entity.NewError(entity.ErrNotFound, "no watch found")
+		}
+		return unmarshalWatch(data, &watch)
+	})
+	return watch, err
+}
+
+// ListWatches returns all watches, the oldest first.
+func (s *WatchStore) ListWatches(_ context.Context) ([]entity.Watch, error) {
+	watches := []entity.Watch{}
+	err := s.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(watchesBucket).ForEach(func(_, data []byte) error {
+			var watch entity.Watch
+			if err := unmarshalWatch(data, &watch); err != nil {
+				return err
+			}
+			watches = append(watches, watch)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(watches, func(a, b entity.Watch) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return watches, nil
+}
+
+func (s *WatchStore) DeleteWatch(_ context.Context, id string) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(watchesBucket)
+		if bucket.Get([]byte(id)) == nil {
+			return entity.NewError(entity.ErrNotFound, "no watch found")
+		}
+		return bucket.Delete([]byte(id))
+	})
+}
+
+func (s *WatchStore) update(fn func(tx *bolt.Tx) error) error {
+	return s.withDB(false, func(db *bolt.DB) error {
+		return db.Update(fn)
+	})
+}
+
+func (s *WatchStore) view(fn func(tx *bolt.Tx) error) error {
+	return s.withDB(true, func(db *bolt.DB) error {
+		return db.View(fn)
+	})
+}
+
+// withDB opens the database, waiting for other processes to release it.
+// bbolt's lock is held per open file, so opens within this process are
+// serialised as well.
+func (s *WatchStore) withDB(readOnly bool, fn func(db *bolt.DB) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, err := bolt.Open(s.path, 0o600, &bolt.Options{Timeout: lockTimeout, ReadOnly: readOnly})
+	if err != nil {
+		return fmt.Errorf("open watch store: %w", err)
+	}
+	defer db.Close()
+
+	return fn(db)
+}
+
+func putWatch(bucket *bolt.Bucket, watch entity.Watch) error {
+	data, err := json.Marshal(watch)
+	if err != nil {
+		return fmt.Errorf("marshal watch: %w", err)
+	}
+	return bucket.Put([]byte(watch.ID), data)
+}
+
+func unmarshalWatch(data []byte, watch *entity.Watch) error {
+	if err := json.Unmarshal(data, watch); err != nil {
+		return fmt.Errorf("unmarshal watch: %w", err)
+	}
+	return nil
+}
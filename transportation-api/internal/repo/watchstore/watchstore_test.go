@@ -0,0 +1,63 @@
+package watchstore
+
+import (
+	"context"
+	"kompass/config"
+	"kompass/internal/entity"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Watch{StorePath: filepath.Join(t.TempDir(), "data", "watches.db")}
+
+	store, err := New(cfg)
+	require.NoError(t, err)
+
+	created := time.Date(2025, 9, 20, 8, 0, 0, 0, time.UTC)
+	require.NoError(t, store.CreateWatch(ctx, entity.Watch{ID: "b", SubscriberKey: "user", CreatedAt: created.Add(time.Minute)}))
+	require.NoError(t, store.CreateWatch(ctx, entity.Watch{ID: "a", SubscriberKey: "user", CreatedAt: created}))
+	require.NoError(t, store.UpdateWatch(ctx, entity.Watch{ID: "a", SubscriberKey: "other", CreatedAt: created}))
+	require.NoError(t, store.DeleteWatch(ctx, "b"))
+
+	reopened, err := New(cfg)
+	require.NoError(t, err)
+	watches, err := reopened.ListWatches(ctx)
+	require.NoError(t, err)
+	require.Len(t, watches, 1)
+	assert.Equal(t, "other", watches[0].SubscriberKey)
+	assert.True(t, created.Equal(watches[0].CreatedAt))
+}
+
+func TestMissingWatch(t *testing.T) {
+	ctx := context.Background()
+	store, err := New(config.Watch{StorePath: filepath.Join(t.TempDir(), "watches.db")})
+	require.NoError(t, err)
+
+	_, err = store.RetrieveWatch(ctx, "missing")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+	assert.ErrorIs(t, store.UpdateWatch(ctx, entity.Watch{ID: "missing"}), entity.ErrNotFound)
+	assert.ErrorIs(t, store.DeleteWatch(ctx, "missing"), entity.ErrNotFound)
+}
+
+func TestSharedStore(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Watch{StorePath: filepath.Join(t.TempDir(), "watches.db")}
+
+	first, err := New(cfg)
+	require.NoError(t, err)
+	second, err := New(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, first.CreateWatch(ctx, entity.Watch{ID: "a"}))
+	require.NoError(t, second.CreateWatch(ctx, entity.Watch{ID: "b"}))
+
+	watches, err := first.ListWatches(ctx)
+	require.NoError(t, err)
+	assert.Len(t, watches, 2)
+}
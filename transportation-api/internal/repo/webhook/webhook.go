@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	maxAttempts    = 5
+	initialBackoff = time.Second
+	requestTimeout = 10 * time.Second
+)
+
+// WebhookSender posts watch events signed with HMAC-SHA256. Receivers
+// verify the X-Kompass-Signature header against Sign of the X-Kompass-Timestamp
+// header and the raw body, and deduplicate retries by X-Kompass-Delivery.
+// Events are only sent to public addresses, which is checked again when
+// connecting, as hosts may resolve differently by then.
+type WebhookSender struct {
+	secret  []byte
+	client  *http.Client
+	backoff time.Duration
+}
+
+func New(config config.Watch) *WebhookSender {
+	return &WebhookSender{
+		secret:  []byte(config.WebhookSecret),
+		client:  repo.NewPublicClient(requestTimeout),
+		backoff: initialBackoff,
+	}
+}
+
+// CheckURL only accepts https URLs of public hosts, as long as a secret to
+// sign the events with is configured.
+func (w *WebhookSender) CheckURL(ctx context.Context, rawURL string) error {
+	if len(w.secret) == 0 {
+		return entity.NewError(entity.ErrNotConfigured, "webhooks are not configured")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return entity.NewError(entity.ErrInvalidInput, "webhook URL must be an https URL")
+	}
+
+	addresses, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return entity.WrapError(entity.ErrInvalidInput, "webhook host could not be resolved", err)
+	}
+	for _, address := range addresses {
+		if !repo.IsPublic(address) {
+			return entity.NewError(entity.ErrInvalidInput, "webhook URL must point to a public address")
+		}
+	}
+	return nil
+}
+
+// Sign returns the signature of a webhook body sent at the Unix timestamp.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SendWebhook retries failed deliveries with exponential backoff, unless the
+// receiver rejected the event with a client error.
+func (w *WebhookSender) SendWebhook(ctx context.Context, url string, event entity.WatchEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal JSON: %w", err)
+	}
+
+	backoff := w.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := w.send(ctx, url, event, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == maxAttempts {
+			return fmt.Errorf("deliver event %s after %d attempts: %w", event.ID, attempt, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("deliver event %s: %w", event.ID, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (w *WebhookSender) send(ctx context.Context, url string, event entity.WatchEvent, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("create http request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "kompass-webhooks")
+	req.Header.Set("X-Kompass-Event", string(event.Type))
+	req.Header.Set("X-Kompass-Delivery", event.ID)
+	req.Header.Set("X-Kompass-Timestamp", timestamp)
+	req.Header.Set("X-Kompass-Signature", Sign(w.secret, timestamp, body))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		// connections to private addresses fail the same way on retries
+		return !errors.Is(err, repo.ErrPrivateAddress), fmt.Errorf("do http request: %w", repo.RequestError(err))
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	retry := res.StatusCode >= 500 || res.StatusCode == http.StatusRequestTimeout || res.StatusCode == http.StatusTooManyRequests
+	return retry, repo.StatusError(res.StatusCode)
+}
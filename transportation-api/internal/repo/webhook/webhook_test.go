@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWebhookSignsAndRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, Sign([]byte("secret"), r.Header.Get("X-Kompass-Timestamp"), body), r.Header.Get("X-Kompass-Signature"))
+		assert.Equal(t, "event", r.Header.Get("X-Kompass-Delivery"))
+		assert.Equal(t, "watch.changed", r.Header.Get("X-Kompass-Event"))
+
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := New(config.Watch{WebhookSecret: "secret"})
+	sender.client = server.Client()
+	sender.backoff = time.Millisecond
+
+	err := sender.SendWebhook(context.Background(), server.URL, entity.WatchEvent{ID: "event", Type: entity.WatchChanged})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestSendWebhookDoesNotRetryClientErrors(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	sender := New(config.Watch{WebhookSecret: "secret"})
+	sender.client = server.Client()
+	sender.backoff = time.Millisecond
+
+	err := sender.SendWebhook(context.Background(), server.URL, entity.WatchEvent{ID: "event", Type: entity.WatchChanged})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestSendWebhookRejectsPrivateAddresses(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := New(config.Watch{WebhookSecret: "secret"})
+	sender.backoff = time.Millisecond
+
+	err := sender.SendWebhook(context.Background(), server.URL, entity.WatchEvent{ID: "event", Type: entity.WatchChanged})
+	assert.ErrorIs(t, err, repo.ErrPrivateAddress)
+	assert.Equal(t, int32(0), attempts.Load())
+}
+
+func TestCheckURL(t *testing.T) {
+	ctx := context.Background()
+	sender := New(config.Watch{WebhookSecret: "secret"})
+
+	assert.NoError(t, sender.CheckURL(ctx, "https://93.184.215.14/webhooks"))
+	for _, url := range []string{
+		"http://93.184.215.14/webhooks",
+		"https://127.0.0.1/webhooks",
+		"https://10.0.0.1/webhooks",
+		"https://169.254.169.254/latest/meta-data",
+		"https://[::1]/webhooks",
+		"https://[::ffff:192.168.0.1]/webhooks",
+	} {
+		assert.ErrorIs(t, sender.CheckURL(ctx, url), entity.ErrInvalidInput, url)
+	}
+
+	unsigned := New(config.Watch{})
+	assert.ErrorIs(t, unsigned.CheckURL(ctx, "https://93.184.215.14/webhooks"), entity.ErrNotConfigured)
+}
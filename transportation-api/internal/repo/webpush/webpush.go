@@ -0,0 +1,159 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const requestTimeout = 10 * time.Second
+
+// WebPush sends encrypted Web Push messages, authenticated with VAPID, to
+// the push services of the subscriptions.
+type WebPush struct {
+	key       *ecdsa.PrivateKey
+	publicKey string
+	subject   string
+	ttl       time.Duration
+	client    *http.Client
+}
+
+func New(config config.WebPush) (*WebPush, error) {
+	w := &WebPush{
+		subject: config.VapidSubject,
+		ttl:     config.TTL,
+		client:  repo.NewPublicClient(requestTimeout),
+	}
+	if config.VapidPrivateKey == "" {
+		return w, nil
+	}
+
+	if !strings.HasPrefix(config.VapidSubject, "mailto:") && !strings.HasPrefix(config.VapidSubject, "https:") {
+		return nil, fmt.Errorf("vapid subject must be a mailto: or https: URL")
+	}
+	scalar, err := decodeKey(config.VapidPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode vapid private key: %w", err)
+	}
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), scalar)
+	if err != nil {
+		return nil, fmt.Errorf("parse vapid private key: %w", err)
+	}
+	publicKey, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("encode vapid public key: %w", err)
+	}
+
+	w.key = key
+	w.publicKey = base64.RawURLEncoding.EncodeToString(publicKey)
+	return w, nil
+}
+
+// VapidKey returns the key which clients subscribe with.
+func (w *WebPush) VapidKey() (entity.VapidKey, error) {
+	if w.key == nil {
+		return entity.VapidKey{}, errNotConfigured
+	}
+	return entity.VapidKey{PublicKey: w.publicKey}, nil
+}
+
+// SendPush fails with entity.ErrSubscriptionExpired if the push service no
+// longer knows the subscription.
+func (w *WebPush) SendPush(ctx context.Context, subscription entity.PushSubscription, message entity.PushMessage) error {
+	if w.key == nil {
+		return errNotConfigured
+	}
+	if len(message.Payload) > entity.MaxPushPayloadSize {
+		return entity.NewError(entity.ErrInvalidInput, fmt.Sprintf("push payload exceeds %d bytes", entity.MaxPushPayloadSize))
+	}
+
+	endpoint, err := url.Parse(subscription.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return entity.NewError(entity.ErrInvalidInput, "invalid push subscription endpoint")
+	}
+	uaPublic, err1 := decodeKey(subscription.Keys.P256dh)
+	authSecret, err2 := decodeKey(subscription.Keys.Auth)
+	if err1 != nil || err2 != nil || len(authSecret) != 16 {
+		return entity.NewError(entity.ErrInvalidInput, "invalid push subscription keys")
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return fmt.Errorf("generate ephemeral key: %w", err)
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+	body, err := encrypt(message.Payload, uaPublic, authSecret, asPrivate, salt)
+	if err != nil {
+		return entity.WrapError(entity.ErrInvalidInput, "invalid push subscription keys", err)
+	}
+
+	authorization, err := vapidAuthorization(w.key, w.publicKey, w.subject, endpoint.Scheme+"://"+endpoint.Host, time.Now())
+	if err != nil {
+		return fmt.Errorf("create vapid authorization: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create http request: %w", err)
+	}
+	ttl := w.ttl
+	if message.TTL != nil {
+		ttl = *message.TTL
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(ttl.Seconds())))
+	if message.Urgency != "" {
+		req.Header.Set("Urgency", string(message.Urgency))
+	}
+	if message.Topic != "" {
+		req.Header.Set("Topic", message.Topic)
+	}
+
+	res, err := w.client.Do(req)
+	if errors.Is(err, repo.ErrPrivateAddress) {
+		return entity.WrapError(entity.ErrInvalidInput, "push subscription endpoint must point to a public address", err)
+	}
+	if err != nil {
+		return fmt.Errorf("do http request: %w", repo.RequestError(err))
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return entity.WrapError(entity.ErrSubscriptionExpired, "push subscription expired", fmt.Errorf("http status code %d", res.StatusCode))
+	case res.StatusCode == http.StatusRequestEntityTooLarge:
+		return entity.NewError(entity.ErrInvalidInput, "push payload rejected as too large")
+	default:
+		return repo.StatusError(res.StatusCode)
+	}
+}
+
+var errNotConfigured = entity.NewError(entity.ErrNotConfigured, "web push is not configured")
+
+// decodeKey decodes base64url keys, which browsers return without padding.
+func decodeKey(key string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(key, "="))
+}
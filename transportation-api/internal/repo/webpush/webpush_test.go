@@ -0,0 +1,129 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decode(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := decodeKey(s)
+	require.NoError(t, err)
+	return b
+}
+
+// TestEncrypt uses the example of RFC 8291, appendix A.
+func TestEncrypt(t *testing.T) {
+	asPrivate, err := ecdh.P256().NewPrivateKey(decode(t, "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	require.NoError(t, err)
+
+	body, err := encrypt(
+		[]byte("When I grow up, I want to be a watermelon"),
+		decode(t, "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+		decode(t, "BTBZMqHH6r4Tts7J_aSIgg"),
+		asPrivate,
+		decode(t, "DGv6ra1nlYgDCS1FRnbzlw"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN",
+		base64.RawURLEncoding.EncodeToString(body))
+}
+
+func TestVapidAuthorization(t *testing.T) {
+	w, err := New(config.WebPush{VapidPrivateKey: "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw", VapidSubject: "mailto:ops@example.com"})
+	require.NoError(t, err)
+
+	authorization, err := vapidAuthorization(w.key, w.publicKey, w.subject, "https://push.example.net", time.Unix(1700000000, 0))
+	require.NoError(t, err)
+
+	token, key, ok := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	require.True(t, ok)
+	assert.Equal(t, w.publicKey, key)
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(decode(t, parts[1]), &claims))
+	assert.Equal(t, "https://push.example.net", claims["aud"])
+	assert.Equal(t, "mailto:ops@example.com", claims["sub"])
+	assert.Equal(t, float64(1700000000+12*60*60), claims["exp"])
+
+	publicKey, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), decode(t, key))
+	require.NoError(t, err)
+	signature := decode(t, parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.True(t, ecdsa.Verify(publicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])))
+}
+
+func TestSendPush(t *testing.T) {
+	status := http.StatusCreated
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "3600", r.Header.Get("TTL"))
+		assert.Equal(t, "high", r.Header.Get("Urgency"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "vapid t="))
+		rw.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	w, err := New(config.WebPush{VapidPrivateKey: "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw", VapidSubject: "mailto:ops@example.com", TTL: time.Hour})
+	require.NoError(t, err)
+	w.client = server.Client()
+
+	subscription := entity.PushSubscription{
+		Endpoint: server.URL + "/push/abc",
+		Keys: entity.PushSubscriptionKeys{
+			P256dh: "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+			Auth:   "BTBZMqHH6r4Tts7J_aSIgg",
+		},
+	}
+	message := entity.PushMessage{Payload: []byte(`{"title":"Delayed"}`), Urgency: entity.PushHigh}
+
+	require.NoError(t, w.SendPush(context.Background(), subscription, message))
+
+	status = http.StatusGone
+	assert.ErrorIs(t, w.SendPush(context.Background(), subscription, message), entity.ErrSubscriptionExpired)
+}
+
+func TestSendPushRejectsPrivateEndpoints(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		rw.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	w, err := New(config.WebPush{VapidPrivateKey: "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw", VapidSubject: "mailto:ops@example.com", TTL: time.Hour})
+	require.NoError(t, err)
+
+	subscription := entity.PushSubscription{
+		Endpoint: server.URL + "/push/abc",
+		Keys: entity.PushSubscriptionKeys{
+			P256dh: "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+			Auth:   "BTBZMqHH6r4Tts7J_aSIgg",
+		},
+	}
+
+	err = w.SendPush(context.Background(), subscription, entity.PushMessage{Payload: []byte(`{"title":"Delayed"}`)})
+	assert.ErrorIs(t, err, entity.ErrInvalidInput)
+	assert.ErrorIs(t, err, repo.ErrPrivateAddress)
+	assert.Equal(t, int32(0), requests.Load())
+}
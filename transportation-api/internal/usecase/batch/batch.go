@@ -0,0 +1,89 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// UseCase runs the items of all batches concurrently, limited by a global
+// number of concurrent lookups, so large batches can't exhaust the upstream
+// rate limits.
+type UseCase struct {
+	flights usecase.Flights
+	trains  usecase.Trains
+	limit   *semaphore.Weighted
+}
+
+func New(flights usecase.Flights, trains usecase.Trains, concurrency int) *UseCase {
+	return &UseCase{
+		flights: flights,
+		trains:  trains,
+		limit:   semaphore.NewWeighted(int64(max(1, concurrency))),
+	}
+}
+
+func (uc *UseCase) ProcessBatch(ctx context.Context, items []request.BatchItem) []entity.BatchResult {
+	results := make([]entity.BatchResult, len(items))
+
+	var wg sync.WaitGroup
+	for i, item := range items {
+		results[i].ID = item.ID
+		if err := uc.limit.Acquire(ctx, 1); err != nil {
+			for j := i; j < len(items); j++ {
+				results[j] = entity.BatchResult{ID: items[j].ID, Err: fmt.Errorf("batch aborted: %w", err)}
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer uc.limit.Release(1)
+			uc.process(ctx, item, &results[i])
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (uc *UseCase) process(ctx context.Context, item request.BatchItem, result *entity.BatchResult) {
+	switch {
+	case item.Flight != nil:
+		flight, err := uc.flights.FindFlight(ctx, *item.Flight)
+		if err != nil {
+			result.Err = fmt.Errorf("find flight: %w", err)
+			return
+		}
+		result.Flight = &flight
+	case item.Train != nil:
+		train, err := uc.trains.FindTrainJourney(ctx, *item.Train)
+		if err != nil {
+			result.Err = fmt.Errorf("find train journey: %w", err)
+			return
+		}
+		result.Train = &train
+	case item.TrainTrip != nil:
+		trip, err := uc.trains.FindTrainTrip(ctx, *item.TrainTrip)
+		if err != nil {
+			result.Err = fmt.Errorf("find train trip: %w", err)
+			return
+		}
+		result.TrainTrip = &trip
+	case item.TrainTripSection != nil:
+		train, err := uc.trains.FindTrainTripSection(ctx, *item.TrainTripSection)
+		if err != nil {
+			result.Err = fmt.Errorf("find train trip section: %w", err)
+			return
+		}
+		result.Train = &train
+	default:
+		result.Err = entity.NewError(entity.ErrInvalidInput, "no lookup requested")
+	}
+}
@@ -0,0 +1,125 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLookups resolves flight numbers and train numbers which don't start
+// with "X", tracking the number of concurrent lookups.
+type fakeLookups struct {
+	delay   time.Duration
+	running atomic.Int32
+	maxSeen atomic.Int32
+}
+
+func (f *fakeLookups) track() func() {
+	running := f.running.Add(1)
+	for {
+		seen := f.maxSeen.Load()
+		if running <= seen || f.maxSeen.CompareAndSwap(seen, running) {
+			break
+		}
+	}
+	time.Sleep(f.delay)
+	return func() { f.running.Add(-1) }
+}
+
+func (f *fakeLookups) FindFlight(_ context.Context, flight request.Flight) (entity.Flight, error) {
+	defer f.track()()
+	number := flight.Legs[0].FlightNumber
+	if number[0] == 'X' {
+		return entity.Flight{}, entity.NewError(entity.ErrNotFound, "no matching flight found")
+	}
+	return entity.Flight{Legs: []entity.FlightLeg{{FlightNumber: number}}}, nil
+}
+
+func (f *fakeLookups) LookupTrainStation(context.Context, string) (entity.TrainStation, error) {
+	return entity.TrainStation{}, nil
+}
+
+func (f *fakeLookups) FindTrainJourney(_ context.Context, train request.Train) (entity.Train, error) {
+	defer f.track()()
+	return entity.Train{RefreshToken: train.TrainNumbers[0]}, nil
+}
+
+func (f *fakeLookups) FindTrainTrip(_ context.Context, trip request.TrainTrip) (entity.TrainTrip, error) {
+	defer f.track()()
+	if trip.TrainNumber[0] == 'X' {
+		return entity.TrainTrip{}, entity.NewError(entity.ErrNotFound, "no matching trip found")
+	}
+	return entity.TrainTrip{}, nil
+}
+
+func (f *fakeLookups) FindTrainTripSection(_ context.Context, section request.TrainTripSection) (entity.Train, error) {
+	defer f.track()()
+	return entity.Train{}, nil
+}
+
+func TestProcessBatchErrorsPerItem(t *testing.T) {
+	lookups := &fakeLookups{}
+	uc := New(lookups, lookups, 4)
+
+	results := uc.ProcessBatch(context.Background(), []request.BatchItem{
+		{ID: "flight", Flight: &request.Flight{Legs: []request.FlightLeg{{FlightNumber: "EK412"}}}},
+		{ID: "missing-flight", Flight: &request.Flight{Legs: []request.FlightLeg{{FlightNumber: "XX1"}}}},
+		{ID: "train", Train: &request.Train{TrainNumbers: []string{"ICE707"}}},
+		{ID: "missing-trip", TrainTrip: &request.TrainTrip{TrainNumber: "X 1"}},
+		{ID: "empty"},
+	})
+	require.Len(t, results, 5)
+
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "EK412", results[0].Flight.Legs[0].FlightNumber)
+	assert.ErrorIs(t, results[1].Err, entity.ErrNotFound)
+	assert.Nil(t, results[1].Flight)
+	assert.NoError(t, results[2].Err)
+	assert.Equal(t, "ICE707", results[2].Train.RefreshToken)
+	assert.ErrorIs(t, results[3].Err, entity.ErrNotFound)
+	assert.ErrorIs(t, results[4].Err, entity.ErrInvalidInput)
+}
+
+func TestProcessBatchKeepsOrderAndLimitsConcurrency(t *testing.T) {
+	lookups := &fakeLookups{delay: 10 * time.Millisecond}
+	uc := New(lookups, lookups, 3)
+
+	items := make([]request.BatchItem, 12)
+	for i := range items {
+		number := fmt.Sprintf("EK%d", i)
+		items[i] = request.BatchItem{ID: number, Flight: &request.Flight{Legs: []request.FlightLeg{{FlightNumber: number}}}}
+	}
+
+	results := uc.ProcessBatch(context.Background(), items)
+	require.Len(t, results, len(items))
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		assert.Equal(t, items[i].ID, result.ID)
+		assert.Equal(t, items[i].ID, result.Flight.Legs[0].FlightNumber)
+	}
+	assert.Equal(t, int32(3), lookups.maxSeen.Load())
+}
+
+func TestProcessBatchAborted(t *testing.T) {
+	lookups := &fakeLookups{}
+	uc := New(lookups, lookups, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := uc.ProcessBatch(ctx, []request.BatchItem{
+		{ID: "a", Flight: &request.Flight{Legs: []request.FlightLeg{{FlightNumber: "EK412"}}}},
+		{ID: "b", Flight: &request.Flight{Legs: []request.FlightLeg{{FlightNumber: "EK413"}}}},
+	})
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+	assert.Equal(t, "b", results[1].ID)
+}
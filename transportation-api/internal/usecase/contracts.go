@@ -17,13 +17,24 @@ type (
 		Geocoding Geocoding
 		Flights   Flights
 		Trains    Trains
+		Transit   Transit
+		Batch     Batch
+		Watches   Watches
+		Live      Live
+		Push      Notifications
 		OPTD      opentraveldata.OpenTravelData
 	}
 
 	Geocoding interface {
 		LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error)
+		AutocompleteLocation(ctx context.Context, autocomplete request.Autocomplete) ([]entity.GeocodePlace, error)
+		ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error)
 		LookupTrainStation(ctx context.Context, query string) (entity.TrainStation, error)
-		LookupDirections(ctx context.Context, start entity.Location, end entity.Location, transportationType entity.TransportationType) (*geojson.FeatureCollection, error)
+		LookupDirections(ctx context.Context, directions request.Directions) (entity.Directions, error)
+		PlanRoadTrip(ctx context.Context, roadTrip request.RoadTrip) (entity.RoadTrip, error)
+		LookupMatrix(ctx context.Context, matrix request.Matrix) (entity.Matrix, error)
+		LookupIsochrones(ctx context.Context, isochrones request.Isochrones) (*geojson.FeatureCollection, error)
+		SearchPois(ctx context.Context, pois request.Pois) ([]entity.Poi, error)
 	}
 
 	Flights interface {
@@ -33,5 +44,34 @@ type (
 	Trains interface {
 		LookupTrainStation(ctx context.Context, query string) (entity.TrainStation, error)
 		FindTrainJourney(ctx context.Context, journey request.Train) (entity.Train, error)
+		FindTrainTrip(ctx context.Context, trip request.TrainTrip) (entity.TrainTrip, error)
+		FindTrainTripSection(ctx context.Context, section request.TrainTripSection) (entity.Train, error)
+	}
+
+	Transit interface {
+		LookupStops(ctx context.Context, query string) ([]entity.TransitStop, error)
+		FindTrips(ctx context.Context, trips request.TransitTrips) ([]entity.TransitLeg, error)
+		FindTransit(ctx context.Context, transit request.Transit) (entity.Transit, error)
+		FindAlerts(ctx context.Context, feed *string, routeID *string, stopID *string) ([]entity.ServiceAlert, error)
+	}
+
+	Watches interface {
+		CreateWatch(ctx context.Context, watch request.Watch) (entity.Watch, error)
+		ListWatches(ctx context.Context, subscriberKey string) ([]entity.Watch, error)
+		RetrieveWatch(ctx context.Context, id string) (entity.Watch, error)
+		DeleteWatch(ctx context.Context, id string) error
+	}
+
+	Live interface {
+		Subscribe(ctx context.Context, subjects []entity.LiveSubject, lastEventID string) (<-chan entity.LiveEvent, error)
+	}
+
+	Notifications interface {
+		VapidKey() (entity.VapidKey, error)
+		SendPush(ctx context.Context, push request.Push) error
+	}
+
+	Batch interface {
+		ProcessBatch(ctx context.Context, items []request.BatchItem) []entity.BatchResult
 	}
 )
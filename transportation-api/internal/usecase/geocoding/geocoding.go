@@ -3,6 +3,7 @@ package geocoding
 import (
 	"context"
 	"fmt"
+	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
 	"kompass/internal/usecase"
@@ -12,19 +13,25 @@ import (
 )
 
 type UseCase struct {
-	trains usecase.Trains
-	ors    repo.OpenRouteServiceWebAPI
+	trains   usecase.Trains
+	geocoder repo.Geocoder
+	router   repo.Router
+	ors      repo.OpenRouteServiceWebAPI
+	pois     repo.PoiSearch
 }
 
-func New(trains usecase.Trains, ors repo.OpenRouteServiceWebAPI) *UseCase {
+func New(trains usecase.Trains, geocoder repo.Geocoder, router repo.Router, ors repo.OpenRouteServiceWebAPI, pois repo.PoiSearch) *UseCase {
 	return &UseCase{
-		trains: trains,
-		ors:    ors,
+		trains:   trains,
+		geocoder: geocoder,
+		router:   router,
+		ors:      ors,
+		pois:     pois,
 	}
 }
 
 func (uc *UseCase) LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error) {
-	location, err := uc.ors.LookupLocation(ctx, query)
+	location, err := uc.geocoder.LookupLocation(ctx, query)
 	if err != nil {
 		return entity.GeocodeLocation{}, fmt.Errorf("lookup location: %w", err)
 	}
@@ -32,6 +39,24 @@ func (uc *UseCase) LookupLocation(ctx context.Context, query string) (entity.Geo
 	return location, nil
 }
 
+func (uc *UseCase) AutocompleteLocation(ctx context.Context, request request.Autocomplete) ([]entity.GeocodePlace, error) {
+	places, err := uc.geocoder.AutocompleteLocation(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete location: %w", err)
+	}
+
+	return places, nil
+}
+
+func (uc *UseCase) ReverseGeocode(ctx context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	places, err := uc.geocoder.ReverseGeocode(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("reverse geocode: %w", err)
+	}
+
+	return places, nil
+}
+
 func (uc *UseCase) LookupTrainStation(ctx context.Context, query string) (entity.TrainStation, error) {
 	station, err := uc.trains.LookupTrainStation(ctx, query)
 	if err != nil {
@@ -41,16 +66,51 @@ func (uc *UseCase) LookupTrainStation(ctx context.Context, query string) (entity
 	return station, nil
 }
 
-func (uc *UseCase) LookupDirections(ctx context.Context, start entity.Location, end entity.Location, transportationType entity.TransportationType) (*geojson.FeatureCollection, error) {
-	featureCollection, err := uc.ors.LookupDirections(ctx, start, end, transportationType)
+func (uc *UseCase) LookupDirections(ctx context.Context, request request.Directions) (entity.Directions, error) {
+	if request.Alternatives != nil && *request.Alternatives > 1 && len(request.Waypoints) > 0 {
+		return entity.Directions{}, entity.NewError(entity.ErrInvalidInput, "alternatives are not supported with waypoints")
+	}
+
+	directions, err := uc.router.LookupDirections(ctx, request)
 	if err != nil {
-		return nil, fmt.Errorf("lookup directions: %w", err)
+		return entity.Directions{}, fmt.Errorf("lookup directions: %w", err)
 	}
 
-	featureCollection.Append(geojson.NewFeature(locationToPoint(start)))
-	featureCollection.Append(geojson.NewFeature(locationToPoint(end)))
+	directions.GeoJson.Append(geojson.NewFeature(locationToPoint(request.Start)))
+	directions.GeoJson.Append(geojson.NewFeature(locationToPoint(request.End)))
 	
-	return featureCollection, nil
+	return directions, nil
+}
+
+func (uc *UseCase) LookupMatrix(ctx context.Context, request request.Matrix) (entity.Matrix, error) {
+	matrix, err := uc.ors.LookupMatrix(ctx, request)
+	if err != nil {
+		return entity.Matrix{}, fmt.Errorf("lookup matrix: %w", err)
+	}
+
+	if request.Optimize {
+		matrix.Order = orderStops(matrix.DurationsInSeconds)
+	}
+
+	return matrix, nil
+}
+
+func (uc *UseCase) LookupIsochrones(ctx context.Context, request request.Isochrones) (*geojson.FeatureCollection, error) {
+	isochrones, err := uc.ors.LookupIsochrones(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("lookup isochrones: %w", err)
+	}
+
+	return isochrones, nil
+}
+
+func (uc *UseCase) SearchPois(ctx context.Context, request request.Pois) ([]entity.Poi, error) {
+	pois, err := uc.pois.SearchPois(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("search pois: %w", err)
+	}
+
+	return pois, nil
 }
 
 func locationToPoint(location entity.Location) orb.Point {
@@ -0,0 +1,226 @@
+package geocoding
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/paulmach/orb/geojson"
+)
+
+// PlanRoadTrip splits the route into daily stages of equal driving time and
+// suggests the closest town to the end of each stage for the night.
+func (uc *UseCase) PlanRoadTrip(ctx context.Context, roadTrip request.RoadTrip) (entity.RoadTrip, error) {
+	directions, err := uc.router.LookupDirections(ctx, request.Directions{
+		Start:              roadTrip.Start,
+		Waypoints:          roadTrip.Waypoints,
+		End:                roadTrip.End,
+		TransportationType: roadTrip.TransportationType,
+		Avoid:              roadTrip.Avoid,
+		Language:           roadTrip.Language,
+	})
+	if err != nil {
+		return entity.RoadTrip{}, fmt.Errorf("lookup directions: %w", err)
+	}
+	if len(directions.Routes) == 0 || len(directions.GeoJson.Features) == 0 {
+		return entity.RoadTrip{}, entity.NewError(entity.ErrNotFound, "no route found")
+	}
+
+	route := directions.Routes[0]
+	lineString, ok := directions.GeoJson.Features[0].Geometry.(orb.LineString)
+	if !ok {
+		return entity.RoadTrip{}, fmt.Errorf("unexpected route geometry %s", directions.GeoJson.Features[0].Geometry.GeoJSONType())
+	}
+
+	maxDailyDuration := float64(roadTrip.MaxDailyDrivingMinutes * 60)
+	days := max(1, int(math.Ceil(route.DurationInSeconds/maxDailyDuration)))
+
+	// The router's distance may differ slightly from the length of the
+	// simplified LineString, so the split points are scaled to the latter.
+	scale := 1.0
+	if route.DistanceInMeters > 0 {
+		scale = geo.Length(lineString) / route.DistanceInMeters
+	}
+
+	splits := []float64{}
+	for day := 1; day < days; day++ {
+		splits = append(splits, distanceAfter(route, route.DurationInSeconds*float64(day)/float64(days)))
+	}
+	scaled := make([]float64, len(splits))
+	for i, split := range splits {
+		scaled[i] = split * scale
+	}
+	parts := splitLineString(lineString, scaled)
+
+	trip := entity.RoadTrip{
+		DistanceInMeters:  route.DistanceInMeters,
+		DurationInSeconds: route.DurationInSeconds,
+		Stages:            []entity.RoadTripStage{},
+	}
+
+	for i, part := range parts {
+		start, end := 0.0, route.DistanceInMeters
+		if i > 0 {
+			start = splits[i-1]
+		}
+		if i < len(splits) {
+			end = splits[i]
+		}
+
+		stage := entity.RoadTripStage{
+			Day:               i + 1,
+			Start:             pointToLocation(part[0]),
+			End:               pointToLocation(part[len(part)-1]),
+			DistanceInMeters:  end - start,
+			DurationInSeconds: durationAt(route, end) - durationAt(route, start),
+		}
+		if i == 0 {
+			stage.Start = roadTrip.Start
+		}
+		if i == len(parts)-1 {
+			stage.End = roadTrip.End
+		} else {
+			stage.Overnight, err = uc.findOvernight(ctx, stage.End)
+			if err != nil {
+				return entity.RoadTrip{}, fmt.Errorf("find overnight stop for day %d: %w", stage.Day, err)
+			}
+		}
+
+		stage.GeoJson = geojson.NewFeature(part)
+		stage.GeoJson.Properties["day"] = stage.Day
+		stage.GeoJson.Properties["summary"] = map[string]interface{}{
+			"distance": stage.DistanceInMeters,
+			"duration": stage.DurationInSeconds,
+		}
+
+		trip.Stages = append(trip.Stages, stage)
+	}
+
+	return trip, nil
+}
+
+// findOvernight looks for the town closest to the route.
+func (uc *UseCase) findOvernight(ctx context.Context, location entity.Location) (*entity.GeocodePlace, error) {
+	places, err := uc.geocoder.ReverseGeocodeLocality(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("reverse geocode: %w", err)
+	}
+	if len(places) == 0 {
+		return nil, nil
+	}
+
+	for _, place := range places {
+		if place.Layer == "locality" {
+			return &place, nil
+		}
+	}
+	return &places[0], nil
+}
+
+// distanceAfter returns the distance (m) driven after the given duration (s).
+// The steps are used to account for different speeds along the route,
+// otherwise a constant speed is assumed.
+func distanceAfter(route entity.Route, duration float64) float64 {
+	if route.DurationInSeconds <= 0 {
+		return 0
+	}
+	if !hasMatchingSteps(route) {
+		return route.DistanceInMeters * duration / route.DurationInSeconds
+	}
+
+	var distance, elapsed float64
+	for _, step := range route.Steps {
+		if elapsed+step.DurationInSeconds >= duration && step.DurationInSeconds > 0 {
+			return distance + step.DistanceInMeters*(duration-elapsed)/step.DurationInSeconds
+		}
+		distance += step.DistanceInMeters
+		elapsed += step.DurationInSeconds
+	}
+	return distance
+}
+
+// durationAt is the inverse of distanceAfter, returning the duration (s)
+// driven until the given distance (m).
+func durationAt(route entity.Route, distance float64) float64 {
+	if route.DistanceInMeters <= 0 {
+		return 0
+	}
+	if !hasMatchingSteps(route) {
+		return route.DurationInSeconds * distance / route.DistanceInMeters
+	}
+
+	var travelled, elapsed float64
+	for _, step := range route.Steps {
+		if travelled+step.DistanceInMeters >= distance && step.DistanceInMeters > 0 {
+			return elapsed + step.DurationInSeconds*(distance-travelled)/step.DistanceInMeters
+		}
+		travelled += step.DistanceInMeters
+		elapsed += step.DurationInSeconds
+	}
+	return elapsed
+}
+
+// hasMatchingSteps reports whether the steps add up to the route's duration,
+// so they can be used to interpolate along it.
+func hasMatchingSteps(route entity.Route) bool {
+	var stepDuration float64
+	for _, step := range route.Steps {
+		stepDuration += step.DurationInSeconds
+	}
+	return len(route.Steps) > 0 && math.Abs(stepDuration-route.DurationInSeconds) <= 0.01*route.DurationInSeconds
+}
+
+// splitLineString cuts the LineString at the given ascending distances (m)
+// along it. The split points are interpolated and shared by the adjacent
+// parts.
+func splitLineString(lineString orb.LineString, distances []float64) []orb.LineString {
+	if len(lineString) == 0 {
+		return []orb.LineString{lineString}
+	}
+
+	parts := []orb.LineString{}
+	part := orb.LineString{}
+	travelled := 0.0
+	next := 0
+
+	for i, point := range lineString {
+		if i > 0 {
+			previous := lineString[i-1]
+			segment := geo.Distance(previous, point)
+			for next < len(distances) && distances[next] <= travelled+segment {
+				fraction := 0.0
+				if segment > 0 {
+					fraction = math.Max(0, distances[next]-travelled) / segment
+				}
+				split := orb.Point{
+					previous[0] + fraction*(point[0]-previous[0]),
+					previous[1] + fraction*(point[1]-previous[1]),
+				}
+				parts = append(parts, append(part, split))
+				part = orb.LineString{split}
+				next++
+			}
+			travelled += segment
+		}
+		if len(part) == 0 || part[len(part)-1] != point {
+			part = append(part, point)
+		}
+	}
+
+	for ; next < len(distances); next++ {
+		parts = append(parts, part)
+		part = orb.LineString{part[len(part)-1]}
+	}
+	return append(parts, part)
+}
+
+func pointToLocation(point orb.Point) entity.Location {
+	return entity.Location{
+		Latitude:  float32(point.Lat()),
+		Longitude: float32(point.Lon()),
+	}
+}
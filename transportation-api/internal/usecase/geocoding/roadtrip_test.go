@@ -0,0 +1,172 @@
+package geocoding
+
+import (
+	"context"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/paulmach/orb/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRouter struct {
+	directions entity.Directions
+}
+
+func (r stubRouter) LookupDirections(context.Context, request.Directions) (entity.Directions, error) {
+	return r.directions, nil
+}
+
+// stubGeocoder finds a town wherever it is asked.
+type stubGeocoder struct {
+	locations []entity.Location
+}
+
+func (g *stubGeocoder) LookupLocation(context.Context, string) (entity.GeocodeLocation, error) {
+	return entity.GeocodeLocation{}, nil
+}
+
+func (g *stubGeocoder) AutocompleteLocation(context.Context, request.Autocomplete) ([]entity.GeocodePlace, error) {
+	return nil, nil
+}
+
+func (g *stubGeocoder) ReverseGeocode(context.Context, entity.Location) ([]entity.GeocodePlace, error) {
+	return nil, nil
+}
+
+func (g *stubGeocoder) ReverseGeocodeLocality(_ context.Context, location entity.Location) ([]entity.GeocodePlace, error) {
+	g.locations = append(g.locations, location)
+	return []entity.GeocodePlace{{Name: "Town", Layer: "locality", Latitude: location.Latitude, Longitude: location.Longitude}}, nil
+}
+
+// unevenRoute drives 10 km in the first half hour, 60 km in the next 1.5
+// hours and 30 km in the last hour.
+var unevenRoute = entity.Route{
+	DistanceInMeters:  100000,
+	DurationInSeconds: 10800,
+	Steps: []entity.RouteStep{
+		{DistanceInMeters: 10000, DurationInSeconds: 1800},
+		{DistanceInMeters: 60000, DurationInSeconds: 5400},
+		{DistanceInMeters: 30000, DurationInSeconds: 3600},
+	},
+}
+
+func TestDistanceAfter(t *testing.T) {
+	constant := entity.Route{DistanceInMeters: 100000, DurationInSeconds: 10000}
+	mismatched := unevenRoute
+	mismatched.DurationInSeconds = 20000
+
+	tests := []struct {
+		name     string
+		route    entity.Route
+		duration float64
+		want     float64
+	}{
+		{"constant speed without steps", constant, 2500, 25000},
+		{"within the first step", unevenRoute, 900, 5000},
+		{"interpolated within a step", unevenRoute, 3600, 30000},
+		{"at a step boundary", unevenRoute, 7200, 70000},
+		{"at the end", unevenRoute, 10800, 100000},
+		{"beyond the end", unevenRoute, 20000, 100000},
+		{"steps not adding up to the duration", mismatched, 5000, 25000},
+		{"route without duration", entity.Route{DistanceInMeters: 100000}, 100, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, distanceAfter(tt.route, tt.duration), 1e-6)
+		})
+	}
+}
+
+func TestDurationAt(t *testing.T) {
+	for _, duration := range []float64{0, 900, 1800, 3600, 7200, 9000, 10800} {
+		assert.InDelta(t, duration, durationAt(unevenRoute, distanceAfter(unevenRoute, duration)), 1e-6)
+	}
+	assert.InDelta(t, 2500.0, durationAt(entity.Route{DistanceInMeters: 100000, DurationInSeconds: 10000}, 25000), 1e-6)
+	assert.Equal(t, 0.0, durationAt(entity.Route{}, 100))
+}
+
+func TestPlanRoadTrip(t *testing.T) {
+	// the LineString is longer than the router's distance, so the split
+	// points have to be scaled to it
+	lineString := orb.LineString{{13.0, 52.0}, {13.0, 52.5}, {13.0, 53.0}}
+	require.Greater(t, geo.Length(lineString), unevenRoute.DistanceInMeters)
+
+	geoJson := geojson.NewFeatureCollection()
+	geoJson.Append(geojson.NewFeature(lineString))
+	geocoder := &stubGeocoder{}
+	uc := &UseCase{
+		router:   stubRouter{directions: entity.Directions{Routes: []entity.Route{unevenRoute}, GeoJson: geoJson}},
+		geocoder: geocoder,
+	}
+
+	start := entity.Location{Latitude: 52.0001, Longitude: 13.0}
+	end := entity.Location{Latitude: 52.9999, Longitude: 13.0}
+
+	tests := []struct {
+		name       string
+		maxMinutes int
+		distances  []float64
+		durations  []float64
+		ends       []float32
+	}{
+		{"single day", 360, []float64{100000}, []float64{10800}, nil},
+		{"split by duration", 60, []float64{30000, 40000, 30000}, []float64{3600, 3600, 3600}, []float32{52.3, 52.7}},
+		{"driving time spread evenly", 100, []float64{50000, 50000}, []float64{5400, 5400}, []float32{52.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			geocoder.locations = nil
+			trip, err := uc.PlanRoadTrip(context.Background(), request.RoadTrip{
+				Start:                  start,
+				End:                    end,
+				TransportationType:     entity.CAR,
+				MaxDailyDrivingMinutes: tt.maxMinutes,
+			})
+			require.NoError(t, err)
+			require.Len(t, trip.Stages, len(tt.distances))
+
+			assert.Equal(t, unevenRoute.DistanceInMeters, trip.DistanceInMeters)
+			assert.Equal(t, start, trip.Stages[0].Start)
+			last := trip.Stages[len(trip.Stages)-1]
+			assert.Equal(t, end, last.End)
+			assert.Nil(t, last.Overnight)
+
+			for i, stage := range trip.Stages {
+				assert.Equal(t, i+1, stage.Day)
+				assert.InDelta(t, tt.distances[i], stage.DistanceInMeters, 1e-6)
+				assert.InDelta(t, tt.durations[i], stage.DurationInSeconds, 1e-6)
+				assert.Equal(t, i+1, stage.GeoJson.Properties["day"])
+				if i > 0 {
+					assert.Equal(t, trip.Stages[i-1].End, stage.Start)
+				}
+			}
+			for i, latitude := range tt.ends {
+				assert.InDelta(t, latitude, trip.Stages[i].End.Latitude, 1e-3)
+				require.NotNil(t, trip.Stages[i].Overnight)
+				assert.Equal(t, "Town", trip.Stages[i].Overnight.Name)
+			}
+			assert.Len(t, geocoder.locations, len(tt.ends))
+		})
+	}
+}
+
+func TestSplitLineString(t *testing.T) {
+	lineString := orb.LineString{{13.0, 52.0}, {13.0, 52.01}, {13.0, 52.02}}
+	length := geo.Length(lineString)
+
+	parts := splitLineString(lineString, []float64{length / 4, length * 3 / 4})
+
+	require.Len(t, parts, 3)
+	assert.InDelta(t, 52.005, parts[0][1].Lat(), 1e-6)
+	assert.Equal(t, parts[0][1], parts[1][0])
+	assert.Equal(t, orb.Point{13.0, 52.01}, parts[1][1])
+	assert.Equal(t, parts[1][2], parts[2][0])
+	assert.Equal(t, orb.Point{13.0, 52.02}, parts[2][1])
+}
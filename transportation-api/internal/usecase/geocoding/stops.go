@@ -0,0 +1,60 @@
+package geocoding
+
+import (
+	"math"
+	"slices"
+)
+
+// orderStops suggests the sequence of visiting all stops with the least total
+// cost, starting at the first stop. It's a nearest neighbour tour improved by
+// 2-opt, which is good enough for the few stops of a day. Missing costs are
+// treated as unreachable.
+func orderStops(costs [][]*float64) []int {
+	if len(costs) == 0 {
+		return []int{}
+	}
+
+	cost := func(from, to int) float64 {
+		if costs[from][to] == nil {
+			return math.Inf(1)
+		}
+		return *costs[from][to]
+	}
+	total := func(order []int) float64 {
+		sum := 0.0
+		for i := 1; i < len(order); i++ {
+			sum += cost(order[i-1], order[i])
+		}
+		return sum
+	}
+
+	order := []int{0}
+	visited := make([]bool, len(costs))
+	visited[0] = true
+	for len(order) < len(costs) {
+		current, next := order[len(order)-1], -1
+		for candidate := range costs {
+			if !visited[candidate] && (next < 0 || cost(current, candidate) < cost(current, next)) {
+				next = candidate
+			}
+		}
+		visited[next] = true
+		order = append(order, next)
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < len(order)-1; i++ {
+			for j := i + 1; j < len(order); j++ {
+				candidate := slices.Clone(order)
+				slices.Reverse(candidate[i : j+1])
+				if total(candidate) < total(order) {
+					order = candidate
+					improved = true
+				}
+			}
+		}
+	}
+
+	return order
+}
@@ -0,0 +1,27 @@
+package geocoding
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderStops(t *testing.T) {
+	positions := []float64{0, 3, 1, 2}
+	costs := make([][]*float64, len(positions))
+	for i := range positions {
+		costs[i] = make([]*float64, len(positions))
+		for j := range positions {
+			cost := math.Abs(positions[i] - positions[j])
+			costs[i][j] = &cost
+		}
+	}
+
+	assert.Equal(t, []int{0, 2, 3, 1}, orderStops(costs))
+
+	costs[0][2] = nil
+	order := orderStops(costs)
+	assert.Len(t, order, 4)
+	assert.NotEqual(t, 2, order[1])
+}
@@ -0,0 +1,39 @@
+package notifications
+
+import (
+	"context"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"time"
+)
+
+type UseCase struct {
+	push repo.PushSender
+}
+
+func New(push repo.PushSender) *UseCase {
+	return &UseCase{
+		push: push,
+	}
+}
+
+func (uc *UseCase) VapidKey() (entity.VapidKey, error) {
+	return uc.push.VapidKey()
+}
+
+func (uc *UseCase) SendPush(ctx context.Context, push request.Push) error {
+	message := entity.PushMessage{Payload: push.Notification}
+	if push.TTL != nil {
+		ttl := time.Duration(*push.TTL) * time.Second
+		message.TTL = &ttl
+	}
+	if push.Urgency != nil {
+		message.Urgency = entity.PushUrgency(*push.Urgency)
+	}
+	if push.Topic != nil {
+		message.Topic = *push.Topic
+	}
+
+	return uc.push.SendPush(ctx, push.Subscription, message)
+}
@@ -1,38 +1,36 @@
 package trains
 
 import (
-	"context"
-	"fmt"
 	"kompass/internal/entity"
 
 	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/geojson"
 )
 
-func (uc *UseCase) createGeoJson(ctx context.Context, train entity.Train) (*geojson.FeatureCollection, error) {
-	legs := train.Legs
+// createGeoJson returns nil if there is neither a leg polyline nor a
+// transfer geometry to draw.
+func (uc *UseCase) createGeoJson(legs []entity.TrainLeg, transfers []entity.TrainTransfer, polylines []entity.TrainPolyline) *geojson.FeatureCollection {
+	featureCollection := geojson.NewFeatureCollection()
 
-	polylines, err := uc.dbVendo.RetrievePolylines(ctx, train.RefreshToken)
-	if err != nil {
-		return nil, fmt.Errorf("retrieve polyline: %w", err)
+	for _, polyline := range polylines {
+		if len(polyline.Polyline.Features) > 0 {
+			featureCollection.Append(geojson.NewFeature(polylineToLineString(polyline.Polyline)))
+		}
 	}
-	if len(polylines) == 0 {
-		return nil, nil
+
+	for _, transfer := range transfers {
+		if transfer.Geometry != nil {
+			featureCollection.Append(transferFeature(transfer))
+		}
 	}
 
-	featureCollection := geojson.NewFeatureCollection()
+	if len(featureCollection.Features) == 0 || len(legs) == 0 {
+		return nil
+	}
 
 	stationByID := map[string]entity.TrainStation{}
 	legsByStation := map[string][]entity.TrainLeg{}
 
-	for _, polyline := range polylines {
-		lineString := orb.LineString{}
-		for _, feature := range polyline.Features {
-			lineString = append(lineString, feature.Geometry.(orb.Point))
-		}
-		featureCollection.Append(geojson.NewFeature(lineString))
-	}
-
 	for _, leg := range legs {
 		stationByID[leg.Origin.ID] = leg.Origin
 		stationByID[leg.Destination.ID] = leg.Destination
@@ -48,7 +46,13 @@ func (uc *UseCase) createGeoJson(ctx context.Context, train entity.Train) (*geoj
 		featureCollection.Append(featureWithProperties(from, to, location, legs))
 	}
 
-	return featureCollection, nil
+	for _, leg := range legs {
+		for _, stopover := range intermediateStopovers(leg) {
+			featureCollection.Append(stopoverFeature(leg, stopover))
+		}
+	}
+
+	return featureCollection
 }
 
 func featureWithProperties(fromMunicipality string, toMunicipality string, location entity.Location, legs []entity.TrainLeg) *geojson.Feature {
@@ -66,6 +70,8 @@ func featureWithProperties(fromMunicipality string, toMunicipality string, locat
 			"arrivalDateTime":   leg.ArrivalDateTime,
 			"fromStation":       leg.Origin.Name,
 			"toStation":         leg.Destination.Name,
+			"departurePlatform": leg.DeparturePlatform,
+			"arrivalPlatform":   leg.ArrivalPlatform,
 		})
 	}
 	feature.Properties["legs"] = legProperties
@@ -73,6 +79,67 @@ func featureWithProperties(fromMunicipality string, toMunicipality string, locat
 	return feature
 }
 
+func transferFeature(transfer entity.TrainTransfer) *geojson.Feature {
+	feature := geojson.NewFeature(transfer.Geometry.Geometry())
+
+	feature.Properties["type"] = transfer.Type
+	feature.Properties["fromStation"] = transfer.Origin.Name
+	feature.Properties["toStation"] = transfer.Destination.Name
+	feature.Properties["departureDateTime"] = transfer.DepartureDateTime
+	feature.Properties["arrivalDateTime"] = transfer.ArrivalDateTime
+	feature.Properties["durationInMinutes"] = transfer.DurationInMinutes
+	feature.Properties["distanceInMeters"] = transfer.DistanceInMeters
+
+	return feature
+}
+
+// applyTransferPolylines replaces the straight lines of transfers by the
+// polylines between the same stations.
+func applyTransferPolylines(transfers []entity.TrainTransfer, polylines []entity.TrainPolyline) {
+	used := make([]bool, len(polylines))
+	for i := range transfers {
+		for j, polyline := range polylines {
+			if used[j] || polyline.OriginID != transfers[i].Origin.ID || polyline.DestinationID != transfers[i].Destination.ID {
+				continue
+			}
+			used[j] = true
+			if len(polyline.Polyline.Features) > 1 {
+				transfers[i].Geometry = geojson.NewGeometry(polylineToLineString(polyline.Polyline))
+			}
+			break
+		}
+	}
+}
+
+func polylineToLineString(polyline geojson.FeatureCollection) orb.LineString {
+	lineString := orb.LineString{}
+	for _, feature := range polyline.Features {
+		lineString = append(lineString, feature.Geometry.(orb.Point))
+	}
+	return lineString
+}
+
+func stopoverFeature(leg entity.TrainLeg, stopover entity.TrainStopover) *geojson.Feature {
+	feature := geojson.NewFeature(locationToPoint(stopover.Station.Location))
+
+	feature.Properties["type"] = "TRAIN_STOPOVER"
+	feature.Properties["lineName"] = leg.LineName
+	feature.Properties["station"] = stopover.Station.Name
+	feature.Properties["arrivalDateTime"] = stopover.ArrivalDateTime
+	feature.Properties["departureDateTime"] = stopover.DepartureDateTime
+	feature.Properties["platform"] = stopover.Platform
+	feature.Properties["cancelled"] = stopover.Cancelled
+
+	return feature
+}
+
+func intermediateStopovers(leg entity.TrainLeg) []entity.TrainStopover {
+	if len(leg.Stopovers) <= 2 {
+		return nil
+	}
+	return leg.Stopovers[1 : len(leg.Stopovers)-1]
+}
+
 func locationToPoint(location entity.Location) orb.Point {
 	return orb.Point{
 		float64(location.Longitude),
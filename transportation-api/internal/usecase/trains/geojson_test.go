@@ -0,0 +1,45 @@
+package trains
+
+import (
+	"kompass/internal/entity"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func polyline(originID, destinationID string, points ...orb.Point) entity.TrainPolyline {
+	featureCollection := geojson.NewFeatureCollection()
+	for _, point := range points {
+		featureCollection.Append(geojson.NewFeature(point))
+	}
+	return entity.TrainPolyline{OriginID: originID, DestinationID: destinationID, Polyline: *featureCollection}
+}
+
+func TestApplyTransferPolylinesByStation(t *testing.T) {
+	transfers := []entity.TrainTransfer{
+		{Origin: entity.TrainStation{ID: "a"}, Destination: entity.TrainStation{ID: "b"}},
+		{Origin: entity.TrainStation{ID: "c"}, Destination: entity.TrainStation{ID: "d"}},
+	}
+	// the first transfer has no polyline, so the counts differ
+	applyTransferPolylines(transfers, []entity.TrainPolyline{polyline("c", "d", orb.Point{1, 1}, orb.Point{2, 2})})
+
+	assert.Nil(t, transfers[0].Geometry)
+	require.NotNil(t, transfers[1].Geometry)
+	assert.Equal(t, orb.LineString{{1, 1}, {2, 2}}, transfers[1].Geometry.Geometry())
+}
+
+func TestCreateGeoJsonWithoutLegPolylines(t *testing.T) {
+	legs := []entity.TrainLeg{{Origin: entity.TrainStation{ID: "a"}, Destination: entity.TrainStation{ID: "b"}}}
+	transfers := []entity.TrainTransfer{{
+		Origin:      entity.TrainStation{ID: "b"},
+		Destination: entity.TrainStation{ID: "c"},
+		Geometry:    geojson.NewGeometry(orb.LineString{{1, 1}, {2, 2}}),
+	}}
+
+	uc := &UseCase{}
+	assert.Nil(t, uc.createGeoJson(legs, nil, []entity.TrainPolyline{polyline("a", "b")}))
+	assert.NotNil(t, uc.createGeoJson(legs, transfers, []entity.TrainPolyline{polyline("a", "b")}))
+}
@@ -9,12 +9,14 @@ import (
 )
 
 type UseCase struct {
-	dbVendo repo.DbVendoWebAPI
+	dbVendo  repo.DbVendoWebAPI
+	realtime repo.GtfsRealtime
 }
 
-func New(api repo.DbVendoWebAPI) *UseCase {
+func New(api repo.DbVendoWebAPI, realtime repo.GtfsRealtime) *UseCase {
 	return &UseCase{
-		dbVendo: api,
+		dbVendo:  api,
+		realtime: realtime,
 	}
 }
 
@@ -28,10 +30,68 @@ func (uc *UseCase) FindTrainJourney(ctx context.Context, request request.Train)
 		return entity.Train{}, fmt.Errorf("failed to retrieve journey: %w", err)
 	}
 
-	train.GeoJson, err = uc.createGeoJson(ctx, train)
+	legPolylines, transferPolylines, err := uc.dbVendo.RetrievePolylines(ctx, train.RefreshToken)
 	if err != nil {
-		return entity.Train{}, fmt.Errorf("failed to create geojson: %w", err)
+		return entity.Train{}, fmt.Errorf("failed to retrieve polylines: %w", err)
 	}
 
+	uc.applyRealtime(train.Legs)
+	applyTransferPolylines(train.Transfers, transferPolylines)
+	train.GeoJson = uc.createGeoJson(train.Legs, train.Transfers, legPolylines)
+
 	return train, nil
 }
+
+func (uc *UseCase) FindTrainTrip(ctx context.Context, request request.TrainTrip) (entity.TrainTrip, error) {
+	trip, err := uc.dbVendo.RetrieveTrip(ctx, request.TrainNumber, request.DepartureDate)
+	if err != nil {
+		return entity.TrainTrip{}, fmt.Errorf("failed to retrieve trip: %w", err)
+	}
+
+	return trip, nil
+}
+
+func (uc *UseCase) FindTrainTripSection(ctx context.Context, request request.TrainTripSection) (entity.Train, error) {
+	trip, err := uc.FindTrainTrip(ctx, request.TrainTrip)
+	if err != nil {
+		return entity.Train{}, err
+	}
+
+	leg, err := sliceTrip(trip, request.FromStationID, request.ToStationID)
+	if err != nil {
+		return entity.Train{}, err
+	}
+
+	var polylines []entity.TrainPolyline
+	if trip.Polyline != nil && len(trip.Polyline.Features) > 0 {
+		polylines = append(polylines, entity.TrainPolyline{
+			OriginID:      leg.Origin.ID,
+			DestinationID: leg.Destination.ID,
+			Polyline:      slicePolyline(*trip.Polyline, leg.Origin.Location, leg.Destination.Location),
+		})
+	}
+
+	legs := []entity.TrainLeg{leg}
+	uc.applyRealtime(legs)
+	return entity.Train{
+		Legs:    legs,
+		GeoJson: uc.createGeoJson(legs, nil, polylines),
+	}, nil
+}
+
+// applyRealtime overrides the delays reported by db-vendo with those of the
+// GTFS-Realtime feeds, for trips whose IDs are known there. The service date
+// of db-vendo trips is unknown, so trips arriving at the boarding stop from
+// elsewhere may have started the day before.
+func (uc *UseCase) applyRealtime(legs []entity.TrainLeg) {
+	for i := range legs {
+		leg := &legs[i]
+		if leg.TripID == "" {
+			continue
+		}
+		overnight := len(leg.Stopovers) > 0 && leg.Stopovers[0].ArrivalDateTime != nil
+		if update, ok := uc.realtime.RetrieveTripUpdate(nil, leg.TripID, leg.DepartureDateTime.Date, overnight); ok {
+			leg.ApplyTripUpdate(update)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package trains
+
+import (
+	"kompass/internal/entity"
+	"math"
+	"time"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+func sliceTrip(trip entity.TrainTrip, fromStationID string, toStationID string) (entity.TrainLeg, error) {
+	fromIdx, toIdx := -1, -1
+	for i, stopover := range trip.Stopovers {
+		if fromIdx == -1 && stopover.Station.ID == fromStationID && stopover.DepartureDateTime != nil {
+			fromIdx = i
+		} else if fromIdx != -1 && stopover.Station.ID == toStationID && stopover.ArrivalDateTime != nil {
+			toIdx = i
+			break
+		}
+	}
+
+	if fromIdx == -1 || toIdx == -1 {
+		return entity.TrainLeg{}, entity.NewError(entity.ErrNotFound, "stations not served by trip in requested order")
+	}
+
+	from := trip.Stopovers[fromIdx]
+	to := trip.Stopovers[toIdx]
+	departure := from.DepartureDateTime.In(time.UTC)
+	arrival := to.ArrivalDateTime.In(time.UTC)
+
+	return entity.TrainLeg{
+		TripID:                    trip.ID,
+		Origin:                    from.Station,
+		Destination:               to.Station,
+		DepartureDateTime:         *from.DepartureDateTime,
+		RealtimeDepartureDateTime: from.RealtimeDepartureDateTime,
+		ArrivalDateTime:           *to.ArrivalDateTime,
+		RealtimeArrivalDateTime:   to.RealtimeArrivalDateTime,
+		Cancelled:                 from.Cancelled || to.Cancelled,
+		DurationInMinutes:         int32(arrival.Sub(departure).Minutes()),
+		LineName:                  trip.LineName,
+		OperatorName:              trip.OperatorName,
+		DeparturePlatform:         from.Platform,
+		ArrivalPlatform:           to.Platform,
+		Stopovers:                 trip.Stopovers[fromIdx : toIdx+1],
+	}, nil
+}
+
+func slicePolyline(polyline geojson.FeatureCollection, from entity.Location, to entity.Location) geojson.FeatureCollection {
+	fromIdx := nearestFeature(polyline.Features, locationToPoint(from), 0)
+	toIdx := nearestFeature(polyline.Features, locationToPoint(to), fromIdx)
+
+	sliced := geojson.NewFeatureCollection()
+	sliced.Features = polyline.Features[fromIdx : toIdx+1]
+	return *sliced
+}
+
+func nearestFeature(features []*geojson.Feature, point orb.Point, startIdx int) int {
+	nearestIdx := startIdx
+	nearestDistance := math.Inf(1)
+
+	for i := startIdx; i < len(features); i++ {
+		featurePoint, ok := features[i].Geometry.(orb.Point)
+		if !ok {
+			continue
+		}
+
+		dx := featurePoint.X() - point.X()
+		dy := featurePoint.Y() - point.Y()
+		if distance := dx*dx + dy*dy; distance < nearestDistance {
+			nearestIdx = i
+			nearestDistance = distance
+		}
+	}
+
+	return nearestIdx
+}
@@ -0,0 +1,70 @@
+package transit
+
+import (
+	"kompass/internal/entity"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+func createGeoJson(legs []entity.TransitLeg, shapes []orb.LineString) *geojson.FeatureCollection {
+	featureCollection := geojson.NewFeatureCollection()
+
+	for _, shape := range shapes {
+		if len(shape) > 1 {
+			featureCollection.Append(geojson.NewFeature(shape))
+		}
+	}
+
+	for _, leg := range legs {
+		featureCollection.Append(featureWithProperties(leg, leg.Origin.Location))
+		featureCollection.Append(featureWithProperties(leg, leg.Destination.Location))
+	}
+
+	return featureCollection
+}
+
+func featureWithProperties(leg entity.TransitLeg, location entity.Location) *geojson.Feature {
+	feature := geojson.NewFeature(locationToPoint(location))
+
+	feature.Properties["type"] = leg.Type
+	feature.Properties["name"] = leg.RouteName + " " + leg.Origin.Name + " - " + leg.Destination.Name
+	feature.Properties["departureDateTime"] = leg.DepartureDateTime
+	feature.Properties["arrivalDateTime"] = leg.ArrivalDateTime
+
+	return feature
+}
+
+func sliceShape(shape orb.LineString, from entity.Location, to entity.Location) orb.LineString {
+	if len(shape) < 2 {
+		return shape
+	}
+
+	fromIdx := nearestPoint(shape, locationToPoint(from), 0)
+	toIdx := nearestPoint(shape, locationToPoint(to), fromIdx)
+	return shape[fromIdx : toIdx+1]
+}
+
+func nearestPoint(lineString orb.LineString, point orb.Point, startIdx int) int {
+	nearestIdx := startIdx
+	nearestDistance := math.Inf(1)
+
+	for i := startIdx; i < len(lineString); i++ {
+		dx := lineString[i].X() - point.X()
+		dy := lineString[i].Y() - point.Y()
+		if distance := dx*dx + dy*dy; distance < nearestDistance {
+			nearestIdx = i
+			nearestDistance = distance
+		}
+	}
+
+	return nearestIdx
+}
+
+func locationToPoint(location entity.Location) orb.Point {
+	return orb.Point{
+		float64(location.Longitude),
+		float64(location.Latitude),
+	}
+}
@@ -0,0 +1,83 @@
+package transit
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
+)
+
+type UseCase struct {
+	gtfs     repo.GtfsFeeds
+	realtime repo.GtfsRealtime
+}
+
+func New(gtfs repo.GtfsFeeds, realtime repo.GtfsRealtime) *UseCase {
+	return &UseCase{
+		gtfs:     gtfs,
+		realtime: realtime,
+	}
+}
+
+func (uc *UseCase) LookupStops(ctx context.Context, query string) ([]entity.TransitStop, error) {
+	return uc.gtfs.LookupStops(ctx, query)
+}
+
+func (uc *UseCase) FindTrips(ctx context.Context, request request.TransitTrips) ([]entity.TransitLeg, error) {
+	legs, err := uc.gtfs.RetrieveTrips(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve trips: %w", err)
+	}
+
+	for i := range legs {
+		uc.applyRealtime(&legs[i], request.Date)
+	}
+
+	return legs, nil
+}
+
+func (uc *UseCase) FindTransit(ctx context.Context, request request.Transit) (entity.Transit, error) {
+	if len(request.Legs) == 0 {
+		return entity.Transit{}, entity.NewError(entity.ErrInvalidInput, "no legs requested")
+	}
+
+	legs := []entity.TransitLeg{}
+	shapes := []orb.LineString{}
+	for _, legRequest := range request.Legs {
+		leg, err := uc.gtfs.RetrieveLeg(ctx, legRequest)
+		if err != nil {
+			return entity.Transit{}, fmt.Errorf("failed to retrieve leg: %w", err)
+		}
+		uc.applyRealtime(&leg, legRequest.Date)
+
+		shape, err := uc.gtfs.RetrieveShape(ctx, leg.Feed, leg.TripID)
+		if err != nil {
+			return entity.Transit{}, fmt.Errorf("failed to retrieve shape: %w", err)
+		}
+
+		legs = append(legs, leg)
+		shapes = append(shapes, sliceShape(shape, leg.Origin.Location, leg.Destination.Location))
+	}
+
+	return entity.Transit{
+		Legs:    legs,
+		GeoJson: createGeoJson(legs, shapes),
+	}, nil
+}
+
+func (uc *UseCase) FindAlerts(_ context.Context, feed *string, routeID *string, stopID *string) ([]entity.ServiceAlert, error) {
+	return uc.realtime.RetrieveAlerts(feed, routeID, stopID), nil
+}
+
+func (uc *UseCase) applyRealtime(leg *entity.TransitLeg, date civil.Date) {
+	if update, ok := uc.realtime.RetrieveTripUpdate(&leg.Feed, leg.TripID, date, false); ok {
+		leg.ApplyTripUpdate(update)
+	}
+	if vehicle, ok := uc.realtime.RetrieveVehiclePosition(&leg.Feed, leg.TripID); ok {
+		leg.Vehicle = &vehicle
+	}
+}
@@ -0,0 +1,284 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	// replayBufferSize limits the events kept per subject for resumed streams
+	replayBufferSize = 32
+	subscriberBuffer = 64
+)
+
+// Live polls the subjects of all open streams and publishes their changes.
+// Streams of the same subject share one feed, so each subject is only
+// polled once per interval.
+//
+// Event IDs consist of the start time of the process and a sequence number.
+// Streams resumed with the ID of their last event get the buffered events
+// since then, or a fresh snapshot if they missed more than was buffered.
+type Live struct {
+	lookup
+	interval time.Duration
+	limit    *semaphore.Weighted
+	epoch    string
+	wake     chan struct{}
+
+	mu            sync.Mutex
+	seq           uint64
+	feeds         map[string]*feed
+	subscriptions map[chan entity.LiveEvent][]string
+}
+
+type feed struct {
+	subject entity.LiveSubject
+	legs    []entity.WatchLeg
+	failed  bool
+	events  []liveEvent
+	// replayFrom is the sequence number after which all events are buffered
+	replayFrom  uint64
+	subscribers map[chan entity.LiveEvent]struct{}
+}
+
+type liveEvent struct {
+	seq   uint64
+	event entity.LiveEvent
+}
+
+func NewLive(flights usecase.Flights, trains usecase.Trains, interval time.Duration, concurrency int) *Live {
+	return &Live{
+		lookup:        lookup{flights: flights, trains: trains},
+		interval:      interval,
+		limit:         semaphore.NewWeighted(int64(max(1, concurrency))),
+		epoch:         strconv.FormatInt(time.Now().Unix(), 10),
+		wake:          make(chan struct{}, 1),
+		feeds:         map[string]*feed{},
+		subscriptions: map[chan entity.LiveEvent][]string{},
+	}
+}
+
+// Subscribe streams the events of the subjects until the context is
+// cancelled. The channel is closed early if the subscriber falls behind.
+func (l *Live) Subscribe(ctx context.Context, subjects []entity.LiveSubject, lastEventID string) (<-chan entity.LiveEvent, error) {
+	if len(subjects) == 0 {
+		return nil, entity.NewError(entity.ErrInvalidInput, "no subjects to follow")
+	}
+	last, resume := l.parseEventID(lastEventID)
+
+	events := make(chan entity.LiveEvent, subscriberBuffer+len(subjects)*replayBufferSize)
+	keys := []string{}
+	pending := false
+
+	l.mu.Lock()
+	for _, subject := range subjects {
+		f, ok := l.feeds[subject.Key]
+		if !ok {
+			f = &feed{subject: subject, replayFrom: l.seq, subscribers: map[chan entity.LiveEvent]struct{}{}}
+			l.feeds[subject.Key] = f
+			pending = true
+		}
+		if _, ok := f.subscribers[events]; ok {
+			continue
+		}
+		f.subscribers[events] = struct{}{}
+		keys = append(keys, subject.Key)
+		l.catchUp(f, events, last, resume)
+	}
+	l.subscriptions[events] = keys
+	l.mu.Unlock()
+
+	if pending {
+		select {
+		case l.wake <- struct{}{}:
+		default:
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.unsubscribe(events)
+	}()
+
+	return events, nil
+}
+
+// Start polls the subjects of all streams until the context is cancelled.
+// New subjects are polled right away.
+func (l *Live) Start(ctx context.Context, log logger.Interface) {
+	go func() {
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				l.poll(ctx, log, false)
+			case <-l.wake:
+				l.poll(ctx, log, true)
+			}
+		}
+	}()
+}
+
+func (l *Live) poll(ctx context.Context, log logger.Interface, pendingOnly bool) {
+	l.mu.Lock()
+	subjects := make([]entity.LiveSubject, 0, len(l.feeds))
+	for _, f := range l.feeds {
+		if !pendingOnly || (f.legs == nil && !f.failed) {
+			subjects = append(subjects, f.subject)
+		}
+	}
+	l.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, subject := range subjects {
+		if err := l.limit.Acquire(ctx, 1); err != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer l.limit.Release(1)
+
+			legs, err := l.legs(ctx, subject.Flight, subject.Train)
+			if err != nil {
+				log.Error(fmt.Errorf("watch - poll - subject %s: %w", subject.Key, err))
+			}
+			l.update(subject.Key, legs, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func (l *Live) update(key string, legs []entity.WatchLeg, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, ok := l.feeds[key]
+	if !ok {
+		return
+	}
+
+	switch {
+	case err != nil:
+		// subjects which were found keep their last state on errors
+		if f.legs == nil && !f.failed {
+			f.failed = true
+			l.publish(f, entity.LiveEvent{Type: entity.LiveFailed, Changes: []entity.WatchChange{}, Error: clientMessage(err)})
+		}
+	case f.legs == nil:
+		f.legs = legs
+		f.failed = false
+		l.publish(f, entity.LiveEvent{Type: entity.LiveSnapshot, Changes: []entity.WatchChange{}, Legs: legs})
+	default:
+		if changes := diff(f.legs, legs); len(changes) > 0 {
+			f.legs = legs
+			l.publish(f, entity.LiveEvent{Type: entity.LiveChanged, Changes: changes, Legs: legs})
+		}
+	}
+}
+
+// catchUp sends a new subscriber what it missed. It must be called with the
+// lock held.
+func (l *Live) catchUp(f *feed, events chan entity.LiveEvent, last uint64, resume bool) {
+	switch {
+	case resume && last >= f.replayFrom:
+		for _, e := range f.events {
+			if e.seq > last {
+				events <- e.event
+			}
+		}
+	case f.legs != nil:
+		events <- l.newEvent(f, entity.LiveEvent{Type: entity.LiveSnapshot, Changes: []entity.WatchChange{}, Legs: f.legs}).event
+	case f.failed:
+		for _, e := range f.events {
+			if e.event.Type == entity.LiveFailed {
+				events <- e.event
+			}
+		}
+	}
+}
+
+// publish buffers the event and sends it to all subscribers of the feed.
+// It must be called with the lock held.
+func (l *Live) publish(f *feed, event entity.LiveEvent) {
+	e := l.newEvent(f, event)
+	f.events = append(f.events, e)
+	if len(f.events) > replayBufferSize {
+		f.replayFrom = f.events[0].seq
+		f.events = f.events[1:]
+	}
+
+	for events := range f.subscribers {
+		select {
+		case events <- e.event:
+		default:
+			// the subscriber resumes with the last event it received
+			l.unsubscribe(events)
+		}
+	}
+}
+
+func (l *Live) newEvent(f *feed, event entity.LiveEvent) liveEvent {
+	l.seq++
+	event.ID = l.epoch + "." + strconv.FormatUint(l.seq, 10)
+	event.Subject = f.subject.Key
+	event.OccurredAt = time.Now().UTC()
+	return liveEvent{seq: l.seq, event: event}
+}
+
+// unsubscribe must be called with the lock held.
+func (l *Live) unsubscribe(events chan entity.LiveEvent) {
+	keys, ok := l.subscriptions[events]
+	if !ok {
+		return
+	}
+
+	for _, key := range keys {
+		f := l.feeds[key]
+		delete(f.subscribers, events)
+		if len(f.subscribers) == 0 {
+			delete(l.feeds, key)
+		}
+	}
+	delete(l.subscriptions, events)
+	close(events)
+}
+
+func (l *Live) parseEventID(id string) (uint64, bool) {
+	epoch, seq, ok := strings.Cut(id, ".")
+	if !ok || epoch != l.epoch {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	return n, err == nil
+}
+
+// clientMessage returns the message of domain errors, which are written for
+// clients.
+func clientMessage(err error) string {
+	var e *entity.Error
+	if errors.As(err, &e) {
+		return e.Message
+	}
+	if errors.Is(err, entity.ErrAmbiguous) {
+		return "ambiguous request"
+	}
+	return "lookup failed"
+}
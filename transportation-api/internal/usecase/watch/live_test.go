@@ -0,0 +1,77 @@
+package watch
+
+import (
+	"context"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/pkg/logger"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFlights struct {
+	aircraft atomic.Value
+	calls    atomic.Int32
+}
+
+func (f *fakeFlights) FindFlight(_ context.Context, _ request.Flight) (entity.Flight, error) {
+	f.calls.Add(1)
+	aircraft := f.aircraft.Load().(string)
+	return entity.Flight{Legs: []entity.FlightLeg{{
+		Origin:      entity.Airport{Iata: "SYD"},
+		Destination: entity.Airport{Iata: "DXB"},
+		Aircraft:    &aircraft,
+	}}}, nil
+}
+
+func receive(t *testing.T, events <-chan entity.LiveEvent) entity.LiveEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "no event received")
+		return entity.LiveEvent{}
+	}
+}
+
+func TestLiveSharesPollsAndResumes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	log := logger.New("error")
+
+	flights := &fakeFlights{}
+	flights.aircraft.Store("A380")
+	live := NewLive(flights, nil, time.Hour, 1)
+	subject := entity.LiveSubject{Key: "flight:EK412:2026-01-30", Flight: &entity.WatchedFlight{Date: civil.Date{Year: 2026, Month: 1, Day: 30}, FlightNumber: "EK412"}}
+
+	first, err := live.Subscribe(ctx, []entity.LiveSubject{subject}, "")
+	require.NoError(t, err)
+	second, err := live.Subscribe(ctx, []entity.LiveSubject{subject}, "")
+	require.NoError(t, err)
+
+	live.poll(ctx, log, true)
+	snapshot := receive(t, first)
+	assert.Equal(t, entity.LiveSnapshot, snapshot.Type)
+	assert.Equal(t, snapshot, receive(t, second))
+	assert.Equal(t, int32(1), flights.calls.Load())
+
+	flights.aircraft.Store("B777")
+	live.poll(ctx, log, false)
+	changed := receive(t, first)
+	assert.Equal(t, entity.LiveChanged, changed.Type)
+	assert.Equal(t, "aircraft", changed.Changes[0].Field)
+
+	resumed, err := live.Subscribe(ctx, []entity.LiveSubject{subject}, snapshot.ID)
+	require.NoError(t, err)
+	assert.Equal(t, changed, receive(t, resumed))
+
+	fresh, err := live.Subscribe(ctx, []entity.LiveSubject{subject}, "0.1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.LiveSnapshot, receive(t, fresh).Type)
+}
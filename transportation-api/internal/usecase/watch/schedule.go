@@ -0,0 +1,100 @@
+package watch
+
+import (
+	"kompass/internal/entity"
+	"strconv"
+	"time"
+)
+
+// nextCheck schedules the next poll by the time until the earliest possible
+// departure. Journeys under way are polled at the shortest interval.
+func nextCheck(legs []entity.WatchLeg, now time.Time) time.Time {
+	if len(legs) == 0 {
+		return now.Add(finalPollInterval)
+	}
+
+	untilDeparture := legs[0].DepartureDateTime.In(time.UTC).Add(-maxZoneOffset).Sub(now)
+	for _, p := range pollIntervals {
+		if untilDeparture > p.before {
+			return now.Add(p.interval)
+		}
+	}
+	return now.Add(finalPollInterval)
+}
+
+// expired reports whether the last leg arrived in any time zone.
+func expired(legs []entity.WatchLeg, now time.Time) bool {
+	if len(legs) == 0 {
+		return false
+	}
+
+	last := legs[len(legs)-1]
+	arrival := last.ArrivalDateTime
+	if last.RealtimeArrivalDateTime != nil && last.RealtimeArrivalDateTime.After(arrival) {
+		arrival = *last.RealtimeArrivalDateTime
+	}
+	return now.After(arrival.In(time.UTC).Add(-minZoneOffset).Add(expiryGrace))
+}
+
+// diff lists the changed fields of all legs. Legs which were added or
+// removed are reported by their route.
+func diff(previous, current []entity.WatchLeg) []entity.WatchChange {
+	changes := []entity.WatchChange{}
+	for i := range max(len(previous), len(current)) {
+		if i >= len(previous) || i >= len(current) || previous[i].Origin != current[i].Origin || previous[i].Destination != current[i].Destination {
+			var before, after *string
+			if i < len(previous) {
+				before = route(previous[i])
+			}
+			if i < len(current) {
+				after = route(current[i])
+			}
+			changes = append(changes, entity.WatchChange{Leg: i, Field: "route", Previous: before, Current: after})
+			continue
+		}
+
+		for _, f := range legFields {
+			before, after := f.value(previous[i]), f.value(current[i])
+			if !equal(before, after) {
+				changes = append(changes, entity.WatchChange{Leg: i, Field: f.name, Previous: before, Current: after})
+			}
+		}
+	}
+	return changes
+}
+
+var legFields = []struct {
+	name  string
+	value func(entity.WatchLeg) *string
+}{
+	{"departureDateTime", func(l entity.WatchLeg) *string { return ptr(l.DepartureDateTime.String()) }},
+	{"realtimeDepartureDateTime", func(l entity.WatchLeg) *string { return stringer(l.RealtimeDepartureDateTime) }},
+	{"arrivalDateTime", func(l entity.WatchLeg) *string { return ptr(l.ArrivalDateTime.String()) }},
+	{"realtimeArrivalDateTime", func(l entity.WatchLeg) *string { return stringer(l.RealtimeArrivalDateTime) }},
+	{"departurePlatform", func(l entity.WatchLeg) *string { return l.DeparturePlatform }},
+	{"arrivalPlatform", func(l entity.WatchLeg) *string { return l.ArrivalPlatform }},
+	{"aircraft", func(l entity.WatchLeg) *string { return l.Aircraft }},
+	{"cancelled", func(l entity.WatchLeg) *string { return ptr(strconv.FormatBool(l.Cancelled)) }},
+}
+
+func route(leg entity.WatchLeg) *string {
+	return ptr(leg.Origin + " - " + leg.Destination)
+}
+
+func stringer[T interface{ String() string }](value *T) *string {
+	if value == nil {
+		return nil
+	}
+	return ptr((*value).String())
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func equal(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,66 @@
+package watch
+
+import (
+	"kompass/internal/entity"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/assert"
+)
+
+func leg(departure, arrival string) entity.WatchLeg {
+	dep, _ := civil.ParseDateTime(departure)
+	arr, _ := civil.ParseDateTime(arrival)
+	return entity.WatchLeg{Origin: "SYD", Destination: "DXB", DepartureDateTime: dep, ArrivalDateTime: arr}
+}
+
+func TestNextCheck(t *testing.T) {
+	legs := []entity.WatchLeg{leg("2025-09-20T12:00:00", "2025-09-20T20:00:00")}
+	// the earliest possible departure is 2025-09-19T22:00Z
+	departure := time.Date(2025, 9, 19, 22, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		before   time.Duration
+		interval time.Duration
+	}{
+		{10 * 24 * time.Hour, 12 * time.Hour},
+		{3 * 24 * time.Hour, 3 * time.Hour},
+		{24 * time.Hour, time.Hour},
+		{6 * time.Hour, 15 * time.Minute},
+		{time.Hour, 5 * time.Minute},
+		{-time.Hour, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		now := departure.Add(-tt.before)
+		assert.Equal(t, now.Add(tt.interval), nextCheck(legs, now), tt.before)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	legs := []entity.WatchLeg{leg("2025-09-20T12:00:00", "2025-09-20T20:00:00")}
+	// the latest possible arrival is 2025-09-21T08:00Z
+	arrival := time.Date(2025, 9, 21, 8, 0, 0, 0, time.UTC)
+
+	assert.False(t, expired(legs, arrival))
+	assert.True(t, expired(legs, arrival.Add(expiryGrace+time.Minute)))
+
+	delayed, _ := civil.ParseDateTime("2025-09-20T23:00:00")
+	legs[0].RealtimeArrivalDateTime = &delayed
+	assert.False(t, expired(legs, arrival.Add(expiryGrace+time.Minute)))
+}
+
+func TestDiff(t *testing.T) {
+	previous := []entity.WatchLeg{leg("2025-09-20T12:00:00", "2025-09-20T20:00:00")}
+	current := []entity.WatchLeg{leg("2025-09-20T12:00:00", "2025-09-20T20:00:00"), leg("2025-09-20T22:00:00", "2025-09-21T06:00:00")}
+	delayed, _ := civil.ParseDateTime("2025-09-20T12:30:00")
+	current[0].RealtimeDepartureDateTime = &delayed
+	current[0].Cancelled = true
+
+	assert.Empty(t, diff(previous, previous))
+	assert.Equal(t, []entity.WatchChange{
+		{Leg: 0, Field: "realtimeDepartureDateTime", Previous: nil, Current: ptr("2025-09-20T12:30:00")},
+		{Leg: 0, Field: "cancelled", Previous: ptr("false"), Current: ptr("true")},
+		{Leg: 1, Field: "route", Previous: nil, Current: ptr("SYD - DXB")},
+	}, diff(previous, current))
+}
@@ -0,0 +1,382 @@
+package watch
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// Legs only have local times, so they are compared with the current time
+// by the earliest and latest instant they could refer to.
+const (
+	maxZoneOffset = 14 * time.Hour
+	minZoneOffset = -12 * time.Hour
+	// expiryGrace keeps watches for late changes after the arrival
+	expiryGrace = time.Hour
+	// urgentBeforeDeparture sends changes shortly before departure as
+	// high priority push messages
+	urgentBeforeDeparture = 12 * time.Hour
+)
+
+// pollIntervals adapt polling to the time until departure, as schedules
+// rarely change days ahead but often do shortly before departure.
+var pollIntervals = []struct {
+	before   time.Duration
+	interval time.Duration
+}{
+	{7 * 24 * time.Hour, 12 * time.Hour},
+	{48 * time.Hour, 3 * time.Hour},
+	{12 * time.Hour, time.Hour},
+	{3 * time.Hour, 15 * time.Minute},
+}
+
+const finalPollInterval = 5 * time.Minute
+
+// UseCase persists watches and polls the due ones through the flight and
+// train use cases. Changes are sent to the webhook and the push subscription
+// of the watch, and the stored state is only updated once they were
+// delivered, so failed deliveries are repeated with the next poll.
+type UseCase struct {
+	lookup
+	store    repo.WatchStore
+	webhooks repo.WebhookSender
+	push     repo.PushSender
+	interval time.Duration
+	limit    *semaphore.Weighted
+}
+
+func New(flights usecase.Flights, trains usecase.Trains, store repo.WatchStore, webhooks repo.WebhookSender, push repo.PushSender, interval time.Duration, concurrency int) *UseCase {
+	return &UseCase{
+		lookup:   lookup{flights: flights, trains: trains},
+		store:    store,
+		webhooks: webhooks,
+		push:     push,
+		interval: interval,
+		limit:    semaphore.NewWeighted(int64(max(1, concurrency))),
+	}
+}
+
+func (uc *UseCase) CreateWatch(ctx context.Context, watch request.Watch) (entity.Watch, error) {
+	if watch.WebhookURL != "" {
+		if err := uc.webhooks.CheckURL(ctx, watch.WebhookURL); err != nil {
+			return entity.Watch{}, err
+		}
+	}
+	// deliveries to subscriptions would fail on every poll without a key
+	if watch.PushSubscription != nil {
+		if _, err := uc.push.VapidKey(); err != nil {
+			return entity.Watch{}, err
+		}
+	}
+
+	created := entity.Watch{
+		ID:               newID(),
+		SubscriberKey:    watch.SubscriberKey,
+		WebhookURL:       watch.WebhookURL,
+		PushSubscription: watch.PushSubscription,
+	}
+	if watch.Flight != nil {
+		created.Flight = &entity.WatchedFlight{
+			Date:          watch.Flight.Date,
+			FlightNumber:  watch.Flight.FlightNumber,
+			OriginAirport: watch.Flight.OriginAirport,
+		}
+	}
+	if watch.Train != nil {
+		created.Train = &entity.WatchedTrain{
+			FromStationID: watch.Train.FromStationID,
+			ToStationID:   watch.Train.ToStationID,
+			TrainNumbers:  watch.Train.TrainNumbers,
+			DepartureDate: watch.Train.DepartureDate,
+			ViaStationID:  watch.Train.ViaStationID,
+			Provider:      watch.Train.Provider,
+		}
+	}
+
+	legs, err := uc.legs(ctx, created.Flight, created.Train)
+	if err != nil {
+		return entity.Watch{}, err
+	}
+
+	now := time.Now()
+	if expired(legs, now) {
+		return entity.Watch{}, entity.NewError(entity.ErrInvalidInput, "journey has already arrived")
+	}
+	created.Legs = legs
+	created.CreatedAt = now
+	created.CheckedAt = now
+	created.NextCheckAt = nextCheck(legs, now)
+
+	if err := uc.store.CreateWatch(ctx, created); err != nil {
+		return entity.Watch{}, fmt.Errorf("create watch: %w", err)
+	}
+	return created, nil
+}
+
+func (uc *UseCase) ListWatches(ctx context.Context, subscriberKey string) ([]entity.Watch, error) {
+	watches, err := uc.store.ListWatches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list watches: %w", err)
+	}
+
+	subscribed := []entity.Watch{}
+	for _, watch := range watches {
+		if watch.SubscriberKey == subscriberKey {
+			subscribed = append(subscribed, watch)
+		}
+	}
+	return subscribed, nil
+}
+
+func (uc *UseCase) RetrieveWatch(ctx context.Context, id string) (entity.Watch, error) {
+	return uc.store.RetrieveWatch(ctx, id)
+}
+
+func (uc *UseCase) DeleteWatch(ctx context.Context, id string) error {
+	return uc.store.DeleteWatch(ctx, id)
+}
+
+// Start polls the due watches until the context is cancelled.
+func (uc *UseCase) Start(ctx context.Context, log logger.Interface) {
+	go func() {
+		ticker := time.NewTicker(uc.interval)
+		defer ticker.Stop()
+
+		for {
+			uc.checkWatches(ctx, log)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (uc *UseCase) checkWatches(ctx context.Context, log logger.Interface) {
+	watches, err := uc.store.ListWatches(ctx)
+	if err != nil {
+		log.Error(fmt.Errorf("watch - checkWatches - store.ListWatches: %w", err))
+		return
+	}
+
+	var wg sync.WaitGroup
+	now := time.Now()
+	for _, watch := range watches {
+		if watch.NextCheckAt.After(now) {
+			continue
+		}
+		if err := uc.limit.Acquire(ctx, 1); err != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer uc.limit.Release(1)
+			if err := uc.checkWatch(ctx, watch); err != nil {
+				log.Error(fmt.Errorf("watch - checkWatch - watch %s: %w", watch.ID, err))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (uc *UseCase) checkWatch(ctx context.Context, watch entity.Watch) error {
+	now := time.Now()
+	if expired(watch.Legs, now) {
+		// the watch is removed even if the event can't be delivered, as
+		// there won't be any further changes
+		sendErr := uc.deliver(ctx, &watch, newEvent(entity.WatchExpired, watch, nil, watch.Legs))
+		if err := uc.store.DeleteWatch(ctx, watch.ID); err != nil && !errors.Is(err, entity.ErrNotFound) {
+			return errors.Join(sendErr, fmt.Errorf("delete watch: %w", err))
+		}
+		if sendErr != nil {
+			return fmt.Errorf("send expiry: %w", sendErr)
+		}
+		return nil
+	}
+
+	var checkErr error
+	legs, err := uc.legs(ctx, watch.Flight, watch.Train)
+	if err != nil {
+		checkErr = err
+	} else if changes := diff(watch.Legs, legs); len(changes) > 0 {
+		if err := uc.deliver(ctx, &watch, newEvent(entity.WatchChanged, watch, changes, legs)); err != nil {
+			checkErr = fmt.Errorf("send changes: %w", err)
+		} else {
+			watch.Legs = legs
+		}
+	}
+
+	if watch.WebhookURL == "" && watch.PushSubscription == nil {
+		if err := uc.store.DeleteWatch(ctx, watch.ID); err != nil && !errors.Is(err, entity.ErrNotFound) {
+			return errors.Join(checkErr, fmt.Errorf("delete watch: %w", err))
+		}
+		return checkErr
+	}
+
+	watch.CheckedAt = now
+	watch.NextCheckAt = nextCheck(watch.Legs, now)
+	if err := uc.store.UpdateWatch(ctx, watch); err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return errors.Join(checkErr, fmt.Errorf("update watch: %w", err))
+	}
+	return checkErr
+}
+
+// deliver sends the event to all receivers of the watch. Expired push
+// subscriptions are removed from the watch.
+func (uc *UseCase) deliver(ctx context.Context, watch *entity.Watch, event entity.WatchEvent) error {
+	var errs []error
+	if watch.WebhookURL != "" {
+		if err := uc.webhooks.SendWebhook(ctx, watch.WebhookURL, event); err != nil {
+			errs = append(errs, fmt.Errorf("send webhook: %w", err))
+		}
+	}
+
+	if watch.PushSubscription != nil {
+		message, err := pushMessage(event, time.Now())
+		if err == nil {
+			err = uc.push.SendPush(ctx, *watch.PushSubscription, message)
+		}
+		switch {
+		case errors.Is(err, entity.ErrSubscriptionExpired):
+			watch.PushSubscription = nil
+		case err != nil:
+			errs = append(errs, fmt.Errorf("send push: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// pushMessage leaves out the legs of the event, and the changes too if they
+// don't fit. Receivers retrieve the watch for the complete state.
+func pushMessage(event entity.WatchEvent, now time.Time) (entity.PushMessage, error) {
+	message := entity.PushMessage{Urgency: entity.PushLow}
+	if event.Type == entity.WatchChanged {
+		message.Urgency = entity.PushNormal
+		if len(event.Legs) > 0 && event.Legs[0].DepartureDateTime.In(time.UTC).Add(-maxZoneOffset).Sub(now) < urgentBeforeDeparture {
+			message.Urgency = entity.PushHigh
+		}
+	}
+
+	event.Legs = nil
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return entity.PushMessage{}, fmt.Errorf("marshal JSON: %w", err)
+	}
+	if len(payload) > entity.MaxPushPayloadSize {
+		event.Changes = nil
+		if payload, err = json.Marshal(event); err != nil {
+			return entity.PushMessage{}, fmt.Errorf("marshal JSON: %w", err)
+		}
+	}
+
+	message.Payload = payload
+	return message, nil
+}
+
+// lookup finds the current state of watched flights and trains.
+type lookup struct {
+	flights usecase.Flights
+	trains  usecase.Trains
+}
+
+func (l lookup) legs(ctx context.Context, flight *entity.WatchedFlight, train *entity.WatchedTrain) ([]entity.WatchLeg, error) {
+	switch {
+	case flight != nil:
+		found, err := l.flights.FindFlight(ctx, request.Flight{Legs: []request.FlightLeg{{
+			Date:          flight.Date,
+			FlightNumber:  flight.FlightNumber,
+			OriginAirport: flight.OriginAirport,
+		}}})
+		if err != nil {
+			return nil, fmt.Errorf("find flight: %w", err)
+		}
+		return flightLegs(found), nil
+	case train != nil:
+		found, err := l.trains.FindTrainJourney(ctx, request.Train{
+			FromStationID: train.FromStationID,
+			ToStationID:   train.ToStationID,
+			TrainNumbers:  train.TrainNumbers,
+			DepartureDate: train.DepartureDate,
+			ViaStationID:  train.ViaStationID,
+			Provider:      train.Provider,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("find train journey: %w", err)
+		}
+		return trainLegs(found), nil
+	default:
+		return nil, entity.NewError(entity.ErrInvalidInput, "nothing to watch")
+	}
+}
+
+// flightLegs only carries scheduled times and the aircraft, as flight
+// schedules have no gates, realtime delays or cancellations.
+func flightLegs(flight entity.Flight) []entity.WatchLeg {
+	legs := make([]entity.WatchLeg, len(flight.Legs))
+	for i, leg := range flight.Legs {
+		legs[i] = entity.WatchLeg{
+			Origin:            leg.Origin.Iata,
+			Destination:       leg.Destination.Iata,
+			DepartureDateTime: leg.DepartureDateTime,
+			ArrivalDateTime:   leg.ArrivalDateTime,
+			Aircraft:          leg.Aircraft,
+		}
+	}
+	return legs
+}
+
+func trainLegs(train entity.Train) []entity.WatchLeg {
+	legs := make([]entity.WatchLeg, len(train.Legs))
+	for i, leg := range train.Legs {
+		legs[i] = entity.WatchLeg{
+			Origin:                    leg.Origin.Name,
+			Destination:               leg.Destination.Name,
+			DepartureDateTime:         leg.DepartureDateTime,
+			RealtimeDepartureDateTime: leg.RealtimeDepartureDateTime,
+			ArrivalDateTime:           leg.ArrivalDateTime,
+			RealtimeArrivalDateTime:   leg.RealtimeArrivalDateTime,
+			DeparturePlatform:         leg.DeparturePlatform,
+			ArrivalPlatform:           leg.ArrivalPlatform,
+			Cancelled:                 leg.Cancelled,
+		}
+	}
+	return legs
+}
+
+func newEvent(eventType entity.WatchEventType, watch entity.Watch, changes []entity.WatchChange, legs []entity.WatchLeg) entity.WatchEvent {
+	if changes == nil {
+		changes = []entity.WatchChange{}
+	}
+	return entity.WatchEvent{
+		ID:            newID(),
+		Type:          eventType,
+		WatchID:       watch.ID,
+		SubscriberKey: watch.SubscriberKey,
+		OccurredAt:    time.Now().UTC(),
+		Changes:       changes,
+		Legs:          legs,
+	}
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
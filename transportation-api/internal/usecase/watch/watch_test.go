@@ -0,0 +1,70 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type expiredPush struct{}
+
+func (expiredPush) VapidKey() (entity.VapidKey, error) {
+	return entity.VapidKey{}, nil
+}
+
+func (expiredPush) SendPush(context.Context, entity.PushSubscription, entity.PushMessage) error {
+	return entity.NewError(entity.ErrSubscriptionExpired, "push subscription expired")
+}
+
+type unconfiguredPush struct{}
+
+func (unconfiguredPush) VapidKey() (entity.VapidKey, error) {
+	return entity.VapidKey{}, entity.NewError(entity.ErrNotConfigured, "web push is not configured")
+}
+
+func (unconfiguredPush) SendPush(context.Context, entity.PushSubscription, entity.PushMessage) error {
+	return entity.NewError(entity.ErrNotConfigured, "web push is not configured")
+}
+
+func TestCreateWatchRejectsUnconfiguredPush(t *testing.T) {
+	uc := New(nil, nil, nil, nil, unconfiguredPush{}, time.Minute, 1)
+
+	_, err := uc.CreateWatch(context.Background(), request.Watch{
+		SubscriberKey:    "user",
+		PushSubscription: &entity.PushSubscription{Endpoint: "https://push.example.net"},
+		Flight:           &request.FlightLeg{FlightNumber: "LH717"},
+	})
+	assert.ErrorIs(t, err, entity.ErrNotConfigured)
+}
+
+func TestDeliverRemovesExpiredSubscription(t *testing.T) {
+	uc := New(nil, nil, nil, nil, expiredPush{}, time.Minute, 1)
+	watch := entity.Watch{ID: "watch", PushSubscription: &entity.PushSubscription{Endpoint: "https://push.example.net"}}
+
+	require.NoError(t, uc.deliver(context.Background(), &watch, newEvent(entity.WatchChanged, watch, nil, nil)))
+	assert.Nil(t, watch.PushSubscription)
+}
+
+func TestPushMessage(t *testing.T) {
+	legs := []entity.WatchLeg{leg("2025-09-20T12:00:00", "2025-09-20T20:00:00")}
+	changes := []entity.WatchChange{{Field: "aircraft", Current: ptr(strings.Repeat("A", entity.MaxPushPayloadSize))}}
+	event := newEvent(entity.WatchChanged, entity.Watch{ID: "watch"}, changes, legs)
+
+	message, err := pushMessage(event, time.Date(2025, 9, 19, 20, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, entity.PushHigh, message.Urgency)
+	assert.LessOrEqual(t, len(message.Payload), entity.MaxPushPayloadSize)
+
+	var sent entity.WatchEvent
+	require.NoError(t, json.Unmarshal(message.Payload, &sent))
+	assert.Equal(t, "watch", sent.WatchID)
+	assert.Nil(t, sent.Changes)
+	assert.Nil(t, sent.Legs)
+}
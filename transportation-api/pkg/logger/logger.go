@@ -84,9 +84,9 @@ func (l *Logger) Fatal(message interface{}, args ...interface{}) {
 
 func (l *Logger) log(message string, args ...interface{}) {
 	if len(args) == 0 {
-		l.logger.Info().Msg(message)
+		l.logger.Info().Msg(Redact(message))
 	} else {
-		l.logger.Info().Msgf(message, args...)
+		l.logger.Info().Msg(Redact(fmt.Sprintf(message, args...)))
 	}
 }
 
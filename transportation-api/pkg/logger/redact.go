@@ -0,0 +1,25 @@
+package logger
+
+import (
+	"regexp"
+)
+
+var redactions = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// URLs of upstream services may carry credentials in their query or
+	// user info, so they are removed completely
+	{regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^\s"'<>]+`), "<redacted url>"},
+	{regexp.MustCompile(`(?i)\b(api_?key|access_token|client_secret|password|token)=[^&\s"']+`), "$1=<redacted>"},
+	{regexp.MustCompile(`(?i)\b(authorization:\s*)?(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`), "$1$2 <redacted>"},
+}
+
+// Redact removes URLs and credentials from messages. It is applied to all
+// log messages and to error details returned to clients.
+func Redact(message string) string {
+	for _, r := range redactions {
+		message = r.pattern.ReplaceAllString(message, r.replacement)
+	}
+	return message
+}
@@ -0,0 +1,17 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t,
+		`lookup location: requestAndParseJsonBody: do http request: Get "<redacted url>": dial tcp: i/o timeout`,
+		Redact(`lookup location: requestAndParseJsonBody: do http request: Get "https://api.openrouteservice.org/geocode/search?api_key=secret&text=Berlin": dial tcp: i/o timeout`))
+	assert.Equal(t, "invalid api_key=<redacted>", Redact("invalid api_key=secret"))
+	assert.Equal(t, "header Authorization: Bearer <redacted>", Redact("header Authorization: Bearer abc.def"))
+	assert.Equal(t, "dial <redacted url>", Redact("dial redis://:password@localhost:6379/0"))
+	assert.Equal(t, "no matching flight found", Redact("no matching flight found"))
+}